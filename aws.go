@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/gookit/goutil/arrutil"
+)
+
+var (
+	rxARNPartition = regexp.MustCompile(`^aws(-[a-z]+)*$`)
+	rxARNService   = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	rxARNRegion    = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+	rxS3Bucket     = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+)
+
+// check the AWS ARN: "arn:partition:service:region:account-id:resource"
+// the region and account can be empty, the account can be "aws" for the AWS managed resource.
+func checkAWSARN(s string, services []string) bool {
+	parts := strings.SplitN(s, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[5] == "" {
+		return false
+	}
+
+	if !rxARNPartition.MatchString(parts[1]) || !rxARNService.MatchString(parts[2]) {
+		return false
+	}
+	if region := parts[3]; region != "" && !rxARNRegion.MatchString(region) {
+		return false
+	}
+	if account := parts[4]; account != "" && account != "aws" && (len(account) != 12 || !isDigits(account)) {
+		return false
+	}
+	return len(services) == 0 || arrutil.StringsHas(services, parts[2])
+}
+
+// the reserved prefixes and suffixes of the S3 bucket name
+var (
+	s3BucketReservedPrefixes = []string{"xn--", "sthree-", "amzn-s3-demo-"}
+	s3BucketReservedSuffixes = []string{"-s3alias", "--ol-s3", ".mrap", "--x-s3", "--table-s3"}
+)
+
+// check the S3 bucket name of the general purpose bucket.
+// see https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html
+func checkS3Bucket(s string) bool {
+	if !rxS3Bucket.MatchString(s) || strings.Contains(s, "..") || net.ParseIP(s) != nil {
+		return false
+	}
+
+	for _, prefix := range s3BucketReservedPrefixes {
+		if strings.HasPrefix(s, prefix) {
+			return false
+		}
+	}
+	for _, suffix := range s3BucketReservedSuffixes {
+		if strings.HasSuffix(s, suffix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package validate
+
+import "time"
+
+// Calendar provide the holidays for the "businessDay" and "notHoliday" rules.
+type Calendar interface {
+	// IsHoliday check the date is a holiday
+	IsHoliday(t time.Time) bool
+}
+
+// WeekendCalendar is an optional interface for the Calendar, use for custom
+// the weekend days. default the weekend days are Saturday and Sunday.
+type WeekendCalendar interface {
+	// IsWeekend check the date is a weekend day
+	IsWeekend(t time.Time) bool
+}
+
+// registered calendars. name -> Calendar
+var calendars = make(map[string]Calendar)
+
+// RegisterCalendar register a named calendar, it can be used by the rules.
+//
+// Usage:
+// 	validate.RegisterCalendar("cn", validate.NewDateCalendar("2024-10-01", "2024-10-02"))
+// 	v.StringRule("date", "businessDay:cn")
+func RegisterCalendar(name string, cal Calendar) {
+	if name == "" || cal == nil {
+		configErrorf("calendar name and the calendar instance cannot be empty")
+		return
+	}
+	calendars[name] = cal
+}
+
+// find the registered calendar by name. returns false on it is not registered(NoPanic mode).
+func findCalendar(name string) (Calendar, bool) {
+	cal, ok := calendars[name]
+	if !ok {
+		configErrorf("the calendar '%s' is not registered", name)
+	}
+	return cal, ok
+}
+
+// check the date is a weekend day, use the WeekendCalendar if provided.
+func isWeekend(t time.Time, cal Calendar) bool {
+	if wc, ok := cal.(WeekendCalendar); ok {
+		return wc.IsWeekend(t)
+	}
+
+	wd := t.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+// DateCalendar is a simple Calendar, the holidays are fixed dates.
+type DateCalendar struct {
+	// Weekends the weekend days. default is Saturday and Sunday
+	Weekends []time.Weekday
+	// holiday dates, format: 2006-01-02
+	dates map[string]bool
+}
+
+// NewDateCalendar create a DateCalendar with holiday dates. date format: 2006-01-02
+func NewDateCalendar(dates ...string) *DateCalendar {
+	c := &DateCalendar{dates: make(map[string]bool, len(dates))}
+	return c.AddDates(dates...)
+}
+
+// AddDates add holiday dates. date format: 2006-01-02
+func (c *DateCalendar) AddDates(dates ...string) *DateCalendar {
+	for _, date := range dates {
+		c.dates[date] = true
+	}
+	return c
+}
+
+// IsHoliday check the date is a holiday
+func (c *DateCalendar) IsHoliday(t time.Time) bool {
+	return c.dates[t.Format("2006-01-02")]
+}
+
+// IsWeekend check the date is a weekend day
+func (c *DateCalendar) IsWeekend(t time.Time) bool {
+	if len(c.Weekends) == 0 {
+		return isWeekend(t, nil)
+	}
+
+	wd := t.Weekday()
+	for _, day := range c.Weekends {
+		if day == wd {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,267 @@
+//go:build go1.16
+// +build go1.16
+
+// Command enumgen generate the enum sets registration from the typed constant blocks,
+// so the "enum:UserRole" in the tags keep synchronized with the Go type.
+//
+// Usage:
+//
+//	//go:generate go run github.com/gookit/validate/cmd/enumgen -type=UserRole,OrderStatus
+//	type UserRole int
+//
+//	const (
+//		RoleAdmin UserRole = iota + 1
+//		RoleEditor
+//	)
+//
+// it will generate the "enum_gen.go" in the package dir, the enum name is the type name:
+//
+//	func init() {
+//		validate.RegisterEnum("UserRole", []interface{}{int64(RoleAdmin), int64(RoleEditor)})
+//	}
+//
+// use the name in the "enum" and "notEnum" rules. eg: `validate:"required|enum:UserRole"`
+// use "-prefix" to qualify the name as "prefix.Type" on the type names conflict in the packages.
+// use "-stringer" to register the String() values of the constants, for the types with the stringer.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type options struct {
+	// the package dir
+	dir string
+	// the type names, empty is all the typed constant blocks
+	types []string
+	// the output file name
+	output string
+	// the enum name prefix, empty is the bare type name
+	prefix string
+	// register the String() values
+	stringer bool
+}
+
+func main() {
+	var opts options
+	var types string
+	flag.StringVar(&types, "type", "", "comma separated type names, default is all the typed constants")
+	flag.StringVar(&opts.output, "output", "enum_gen.go", "the output file name in the package dir")
+	flag.StringVar(&opts.prefix, "prefix", "", "the enum name prefix, the name is \"prefix.Type\", default is the bare type name")
+	flag.BoolVar(&opts.stringer, "stringer", false, "register the String() values of the constants")
+	flag.Parse()
+
+	opts.dir = "."
+	if flag.NArg() > 0 {
+		opts.dir = flag.Arg(0)
+	}
+	for _, name := range strings.Split(types, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			opts.types = append(opts.types, name)
+		}
+	}
+
+	if err := run(opts); err != nil {
+		fmt.Fprintln(os.Stderr, "enumgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts options) error {
+	src, err := generate(opts)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(opts.dir, opts.output), src, 0644)
+}
+
+// the constants of a type
+type enumType struct {
+	name string
+	// the basic kind of the underlying type. eg: "int64", "string". empty is unknown
+	kind   string
+	consts []string
+}
+
+// generate the source code of the enum sets registration
+func generate(opts options) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, opts.dir, func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != opts.output
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expect one package in the dir %s, found %d", opts.dir, len(pkgs))
+	}
+
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+
+	enums := collectEnums(pkg)
+	if len(opts.types) > 0 {
+		var selected []*enumType
+		for _, name := range opts.types {
+			et, ok := enums[name]
+			if !ok {
+				return nil, fmt.Errorf("no typed constants found for the type %s", name)
+			}
+			selected = append(selected, et)
+		}
+		return render(pkg.Name, opts.prefix, selected, opts.stringer)
+	}
+
+	if len(enums) == 0 {
+		return nil, errors.New("no typed constants found")
+	}
+
+	names := make([]string, 0, len(enums))
+	for name := range enums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	selected := make([]*enumType, len(names))
+	for i, name := range names {
+		selected[i] = enums[name]
+	}
+	return render(pkg.Name, opts.prefix, selected, opts.stringer)
+}
+
+// collect the typed constants by the type name. the implicit repetition in the
+// const block is supported. eg: "RoleEditor" after "RoleAdmin UserRole = iota"
+func collectEnums(pkg *ast.Package) map[string]*enumType {
+	enums := make(map[string]*enumType)
+	kinds := make(map[string]string)
+
+	// sort the files for the stable output
+	files := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	for _, fileName := range files {
+		for _, decl := range pkg.Files[fileName].Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			if gd.Tok == token.TYPE {
+				for _, spec := range gd.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if ident, ok := ts.Type.(*ast.Ident); ok {
+						kinds[ts.Name.Name] = basicKind(ident.Name)
+					}
+				}
+				continue
+			}
+			if gd.Tok != token.CONST {
+				continue
+			}
+
+			var typeName string
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if vs.Type != nil {
+					typeName = ""
+					if ident, ok := vs.Type.(*ast.Ident); ok {
+						typeName = ident.Name
+					}
+				} else if len(vs.Values) > 0 { // untyped constant
+					typeName = ""
+				}
+				if typeName == "" || basicKind(typeName) != "" {
+					continue
+				}
+
+				et, ok := enums[typeName]
+				if !ok {
+					et = &enumType{name: typeName}
+					enums[typeName] = et
+				}
+				for _, ident := range vs.Names {
+					if ident.Name != "_" {
+						et.consts = append(et.consts, ident.Name)
+					}
+				}
+			}
+		}
+	}
+
+	for name, et := range enums {
+		et.kind = kinds[name]
+	}
+	return enums
+}
+
+// get the converted kind of the basic type. returns empty on it is not the basic type.
+func basicKind(typeName string) string {
+	switch typeName {
+	case "int", "int8", "int16", "int32", "int64", "rune",
+		"uint", "uint8", "uint16", "uint32", "uint64", "byte":
+		return "int64"
+	case "float32", "float64":
+		return "float64"
+	case "string":
+		return "string"
+	}
+	return ""
+}
+
+// get the registered enum name of the type. the prefix avoid the
+// same type names in the different packages override each other.
+func enumName(prefix, typeName string) string {
+	if prefix == "" {
+		return typeName
+	}
+	return prefix + "." + typeName
+}
+
+// render the registration source, the rules of the enum sets are documented in the file header.
+func render(pkgName, prefix string, enums []*enumType, stringer bool) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by enumgen. DO NOT EDIT.\n//\n")
+	buf.WriteString("// Use the registered enum sets by the name in the \"enum\" and \"notEnum\" rules:\n//\n")
+	for _, et := range enums {
+		fmt.Fprintf(&buf, "//\tenum:%s\n", enumName(prefix, et.name))
+	}
+	buf.WriteString("\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	buf.WriteString("import \"github.com/gookit/validate\"\n\n")
+	buf.WriteString("func init() {\n")
+
+	for _, et := range enums {
+		values := make([]string, len(et.consts))
+		for i, name := range et.consts {
+			switch {
+			case stringer:
+				values[i] = name + ".String()"
+			case et.kind != "":
+				values[i] = et.kind + "(" + name + ")"
+			default:
+				values[i] = name
+			}
+		}
+		fmt.Fprintf(&buf, "\tvalidate.RegisterEnum(%q, []interface{}{%s})\n", enumName(prefix, et.name), strings.Join(values, ", "))
+	}
+
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
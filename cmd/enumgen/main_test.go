@@ -0,0 +1,83 @@
+//go:build go1.16
+// +build go1.16
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSource = `package model
+
+type UserRole int
+
+const (
+	RoleAdmin UserRole = iota + 1
+	RoleEditor
+	_
+	RoleViewer
+)
+
+type Status string
+
+const (
+	StatusNew  Status = "new"
+	StatusPaid Status = "paid"
+	maxItems          = 10
+	StatusDone Status = "done"
+)
+
+const Timeout int = 30
+`
+
+func TestGenerate(t *testing.T) {
+	is := assert.New(t)
+
+	dir := t.TempDir()
+	is.NoError(os.WriteFile(filepath.Join(dir, "model.go"), []byte(testSource), 0644))
+
+	src, err := generate(options{dir: dir, output: "enum_gen.go"})
+	is.NoError(err)
+	is.Equal(`// Code generated by enumgen. DO NOT EDIT.
+//
+// Use the registered enum sets by the name in the "enum" and "notEnum" rules:
+//
+//	enum:Status
+//	enum:UserRole
+
+package model
+
+import "github.com/gookit/validate"
+
+func init() {
+	validate.RegisterEnum("Status", []interface{}{string(StatusNew), string(StatusPaid), string(StatusDone)})
+	validate.RegisterEnum("UserRole", []interface{}{int64(RoleAdmin), int64(RoleEditor), int64(RoleViewer)})
+}
+`, string(src))
+
+	src, err = generate(options{dir: dir, output: "enum_gen.go", types: []string{"UserRole"}, stringer: true})
+	is.NoError(err)
+	is.Contains(string(src), `validate.RegisterEnum("UserRole", []interface{}{RoleAdmin.String(), RoleEditor.String(), RoleViewer.String()})`)
+	is.NotContains(string(src), "Status")
+
+	// custom the enum name prefix
+	src, err = generate(options{dir: dir, output: "enum_gen.go", types: []string{"Status"}, prefix: "order"})
+	is.NoError(err)
+	is.Contains(string(src), `validate.RegisterEnum("order.Status", []interface{}{`)
+	is.Contains(string(src), "//\tenum:order.Status\n")
+
+	_, err = generate(options{dir: dir, output: "enum_gen.go", types: []string{"Unknown"}})
+	is.Error(err)
+
+	// write the file, it is skipped on generate again
+	is.NoError(run(options{dir: dir, output: "enum_gen.go"}))
+	src2, err := generate(options{dir: dir, output: "enum_gen.go"})
+	is.NoError(err)
+	gen, err := os.ReadFile(filepath.Join(dir, "enum_gen.go"))
+	is.NoError(err)
+	is.Equal(string(gen), string(src2))
+}
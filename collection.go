@@ -0,0 +1,240 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gookit/goutil/arrutil"
+	"github.com/gookit/goutil/mathutil"
+	"github.com/gookit/goutil/strutil"
+)
+
+// the mode of the case-insensitive compare for the string elements. eg: "distinct:ci"
+const caseInsensitive = "ci"
+
+// check the mode arg is valid, allow: empty, "ci"
+func isCaseInsensitive(name string, mode []string) bool {
+	if len(mode) == 0 || mode[0] == "" {
+		return false
+	}
+	if mode[0] != caseInsensitive {
+		configErrorf("invalid mode '%s' for the validator '%s', allow: %s", mode[0], name, caseInsensitive)
+	}
+	return mode[0] == caseInsensitive
+}
+
+// get the elements of the slice, array value. returns false on the value is not a list.
+func listElements(val interface{}) ([]interface{}, bool) {
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	elems := make([]interface{}, rv.Len())
+	for i := range elems {
+		elems[i] = rv.Index(i).Interface()
+	}
+	return elems, true
+}
+
+// get the map key of the element for compare. the string is lowered on ci is true,
+// the not comparable element(eg: map, slice) is compared by the formatted value.
+func elementKey(elem interface{}, ci bool) interface{} {
+	if str, ok := elem.(string); ok {
+		if ci {
+			return strings.ToLower(str)
+		}
+		return str
+	}
+
+	if elem != nil && !reflect.TypeOf(elem).Comparable() {
+		return fmt.Sprintf("%T:%v", elem, elem)
+	}
+	return elem
+}
+
+// find the first duplicated element of the list.
+func firstDuplicate(elems []interface{}, ci bool) (interface{}, bool) {
+	seen := make(map[interface{}]bool, len(elems))
+	for _, elem := range elems {
+		key := elementKey(elem, ci)
+		if seen[key] {
+			return elem, true
+		}
+		seen[key] = true
+	}
+	return nil, false
+}
+
+// get the value of the element at the key path, support the map, struct and slice. eg: "email", "user.email", "items.0"
+// the struct field can be matched by the name or the json tag name.
+func valueAtPath(elem interface{}, path string) (interface{}, bool) {
+	rv := reflect.ValueOf(elem)
+	for _, key := range strings.Split(path, ".") {
+		for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+			if rv.IsNil() {
+				return nil, false
+			}
+			rv = rv.Elem()
+		}
+
+		switch rv.Kind() {
+		case reflect.Map:
+			if rv.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+			rv = rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()))
+		case reflect.Struct:
+			rv = structFieldByKey(rv, key)
+		case reflect.Slice, reflect.Array:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= rv.Len() {
+				return nil, false
+			}
+			rv = rv.Index(idx)
+		default:
+			return nil, false
+		}
+
+		if !rv.IsValid() {
+			return nil, false
+		}
+	}
+
+	if !rv.CanInterface() {
+		return nil, false
+	}
+	return rv.Interface(), true
+}
+
+// find the exported struct field by the name or the json tag name.
+func structFieldByKey(rv reflect.Value, key string) reflect.Value {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.PkgPath != "" { // unexported
+			continue
+		}
+
+		tagName := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if sf.Name == key || tagName == key {
+			return rv.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+// find the first duplicated value at the key path of the elements. the elements without the path or the value is nil are ignored.
+func firstDuplicateBy(elems []interface{}, path string, ci bool) (interface{}, bool) {
+	values := make([]interface{}, 0, len(elems))
+	for _, elem := range elems {
+		if val, ok := valueAtPath(elem, path); ok && val != nil {
+			values = append(values, val)
+		}
+	}
+	return firstDuplicate(values, ci)
+}
+
+// flatten the list args, the slice arg is expanded. eg: "subsetOf:a,b", "subsetOf:[a,b]", "subsetOf:@allowed"
+func flattenListArgs(args []interface{}) []interface{} {
+	list := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		if elems, ok := listElements(arg); ok {
+			list = append(list, elems...)
+		} else {
+			list = append(list, arg)
+		}
+	}
+	return list
+}
+
+// build the set of the elements. the scalar elements are compared as string, so the
+// field value can be compared with the literal args. eg: []int{1, 2} and "1,2"
+func elementSet(elems []interface{}) map[interface{}]bool {
+	set := make(map[interface{}]bool, len(elems))
+	for _, elem := range elems {
+		set[setKey(elem)] = true
+	}
+	return set
+}
+
+// the extra message params for the slice set validators. {list}: the flattened list args. eg: "[a,b]"
+func listMessageParams(_ interface{}, args []interface{}) map[string]string {
+	return map[string]string{"list": arrutil.ToString(flattenListArgs(args))}
+}
+
+func setKey(elem interface{}) interface{} {
+	if elem == nil {
+		return nil
+	}
+
+	switch reflect.TypeOf(elem).Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return strutil.MustString(elem)
+	}
+	return elementKey(elem, false)
+}
+
+// get the items count of the slice, array or map value. returns false on the value is not a collection.
+func itemCount(val interface{}) (int, bool) {
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len(), true
+	}
+	return 0, false
+}
+
+// the extra message params for the items count validators.
+//   - {items}: the plural noun by the last arg. "item" or "items"
+//   - {min}, {max}: the args of the "itemsBetween"
+func itemsMessageParams(_ interface{}, args []interface{}) map[string]string {
+	params := map[string]string{"items": "items"}
+	if len(args) == 0 {
+		return params
+	}
+
+	if n, err := mathutil.ToInt(args[len(args)-1]); err == nil && (n == 1 || n == -1) {
+		params["items"] = "item"
+	}
+	if len(args) > 1 {
+		params["min"] = strutil.MustString(args[0])
+		params["max"] = strutil.MustString(args[1])
+	}
+	return params
+}
+
+// build the checker of the value contains the item. the string value check the substring,
+// the slice and array value check the element, the map value check the key.
+func containsChecker(val interface{}) (func(item interface{}) bool, bool) {
+	if str, ok := val.(string); ok {
+		return func(item interface{}) bool {
+			return strings.Contains(str, strutil.MustString(item))
+		}, true
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	var set map[interface{}]bool
+	switch rv.Kind() {
+	case reflect.String:
+		return containsChecker(rv.String())
+	case reflect.Slice, reflect.Array:
+		elems, _ := listElements(rv.Interface())
+		set = elementSet(elems)
+	case reflect.Map:
+		keys := make([]interface{}, 0, rv.Len())
+		for _, key := range rv.MapKeys() {
+			keys = append(keys, key.Interface())
+		}
+		set = elementSet(keys)
+	default:
+		return nil, false
+	}
+
+	return func(item interface{}) bool {
+		return set[setKey(item)]
+	}, true
+}
@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gookit/goutil/strutil"
+	"gopkg.in/yaml.v3"
+)
+
+// the default max size(bytes) of the config text, for the xmlWellFormed and yamlParsable rules.
+const defaultMaxTextSize = 1 << 20
+
+// textParseError is the parse error of the config text, with the error location.
+type textParseError struct {
+	// the line number, 0 is unknown.
+	line int
+	msg  string
+}
+
+func (e *textParseError) Error() string {
+	if e.line > 0 {
+		return fmt.Sprintf("line %d: %s", e.line, e.msg)
+	}
+	return e.msg
+}
+
+// get the max size from the rule args, the default is defaultMaxTextSize.
+func textMaxSize(maxSize []int) int {
+	if len(maxSize) > 0 && maxSize[0] > 0 {
+		return maxSize[0]
+	}
+	return defaultMaxTextSize
+}
+
+// check the XML is well-formed: the tags are matched and there is exactly one root element.
+func checkXMLWellFormed(s string, maxSize int) error {
+	if len(s) > maxSize {
+		return &textParseError{msg: fmt.Sprintf("the size exceeds %d bytes", maxSize)}
+	}
+
+	dec := xml.NewDecoder(strings.NewReader(s))
+	var depth, roots int
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if se, ok := err.(*xml.SyntaxError); ok {
+				return &textParseError{line: se.Line, msg: se.Msg}
+			}
+			return &textParseError{msg: err.Error()}
+		}
+
+		switch tt := tok.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				if roots++; roots > 1 {
+					return &textParseError{msg: "multiple root elements"}
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(tt)) != "" {
+				return &textParseError{msg: "text outside the root element"}
+			}
+		}
+	}
+
+	if roots == 0 {
+		return &textParseError{msg: "no root element"}
+	}
+	return nil
+}
+
+// the line number in the yaml error. eg: "yaml: line 3: mapping values are not allowed in this context"
+var rxYAMLErrorLine = regexp.MustCompile(`^yaml: line (\d+): `)
+
+// check the YAML can be parsed, all the documents are checked.
+func checkYAMLParsable(s string, maxSize int) error {
+	if len(s) > maxSize {
+		return &textParseError{msg: fmt.Sprintf("the size exceeds %d bytes", maxSize)}
+	}
+
+	dec := yaml.NewDecoder(strings.NewReader(s))
+	for {
+		var node yaml.Node
+		err := dec.Decode(&node)
+		if err == io.EOF {
+			return nil
+		}
+		if err == nil {
+			continue
+		}
+
+		msg := err.Error()
+		if m := rxYAMLErrorLine.FindStringSubmatch(msg); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			return &textParseError{line: line, msg: msg[len(m[0]):]}
+		}
+		return &textParseError{msg: strings.TrimPrefix(msg, "yaml: ")}
+	}
+}
+
+// the error message params of the config text rules: "{error}" and "{line}"
+func textParseMessageParams(check func(string, int) error) func(val interface{}, args []interface{}) map[string]string {
+	return func(val interface{}, args []interface{}) map[string]string {
+		maxSize := defaultMaxTextSize
+		if len(args) > 0 {
+			if n, err := strutil.ToInt(strutil.MustString(args[0])); err == nil && n > 0 {
+				maxSize = n
+			}
+		}
+
+		str, _ := val.(string)
+		params := map[string]string{"error": "", "line": ""}
+		if err, ok := check(str, maxSize).(*textParseError); ok && err != nil {
+			params["error"] = err.Error()
+			if err.line > 0 {
+				params["line"] = strconv.Itoa(err.line)
+			}
+		}
+		return params
+	}
+}
@@ -0,0 +1,117 @@
+package validate
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gookit/goutil/arrutil"
+)
+
+// the lookalike chars of the Latin letters from the other scripts. from the Unicode confusables data.
+var latinLookalikes = map[rune]rune{
+	// Cyrillic
+	'а': 'a', 'в': 'b', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x',
+	'і': 'i', 'ј': 'j', 'ѕ': 's', 'һ': 'h', 'ԁ': 'd', 'ԛ': 'q', 'ԝ': 'w', 'ӏ': 'l', 'ү': 'y',
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O', 'Р': 'P',
+	'С': 'C', 'Т': 'T', 'Х': 'X', 'У': 'Y', 'І': 'I', 'Ј': 'J', 'Ѕ': 'S', 'Ԛ': 'Q',
+	'Ԝ': 'W', 'Ү': 'Y', 'Ӏ': 'l',
+	// Greek
+	'α': 'a', 'ι': 'i', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'υ': 'u',
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M',
+	'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	// Armenian
+	'օ': 'o', 'ս': 'u', 'հ': 'h', 'ո': 'n', 'ց': 'g', 'զ': 'q',
+}
+
+// the commonly scripts, check them before all the scripts.
+var commonScripts = []string{"Latin", "Cyrillic", "Greek", "Han", "Hiragana", "Katakana", "Hangul", "Arabic"}
+
+// get the script name of the rune. returns "Common" for the digits, punctuation and the combining marks.
+func scriptOf(r rune) string {
+	if r < utf8.RuneSelf {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return "Latin"
+		}
+		return "Common"
+	}
+
+	for _, name := range commonScripts {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	for name, table := range unicode.Scripts {
+		if name != "Inherited" && unicode.Is(table, r) {
+			return name
+		}
+	}
+	return "Common"
+}
+
+// get the augmented script set of the rune. see UTS #39 "Mixed-Script Detection".
+// the Han is used with the Japanese, Korean and the Bopomofo.
+func augmentedScripts(script string) []string {
+	switch script {
+	case "Han":
+		return []string{"Han", "Jpan", "Kore", "Hanb"}
+	case "Hiragana", "Katakana":
+		return []string{"Jpan"}
+	case "Hangul":
+		return []string{"Kore"}
+	case "Bopomofo":
+		return []string{"Hanb"}
+	}
+	return []string{script}
+}
+
+// resolve the script set of the string, the Common and Inherited chars are ignored.
+// returns the empty set on the string is mixed-script, the nil on there are no script chars.
+func resolveScripts(s string) map[string]bool {
+	var set map[string]bool
+	for _, r := range s {
+		script := scriptOf(r)
+		if script == "Common" {
+			continue
+		}
+
+		scripts := augmentedScripts(script)
+		if set == nil {
+			set = make(map[string]bool, len(scripts))
+			for _, name := range scripts {
+				set[name] = true
+			}
+			continue
+		}
+
+		for name := range set {
+			if !arrutil.StringsHas(scripts, name) {
+				delete(set, name)
+			}
+		}
+	}
+	return set
+}
+
+// check the string is confusable with a Latin string.
+// - it mixes the scripts and contains the Latin lookalikes. eg: "pаypal" with the Cyrillic "а"
+// - all the letters are the Latin lookalikes from a non-Latin script. eg: "сор" in Cyrillic
+func isConfusable(s string) bool {
+	var lookalikes, letters int
+	for _, r := range s {
+		if _, ok := latinLookalikes[r]; ok {
+			lookalikes++
+		}
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+	if lookalikes == 0 {
+		return false
+	}
+
+	set := resolveScripts(s)
+	if len(set) == 0 {
+		return true
+	}
+	return !set["Latin"] && lookalikes == letters
+}
@@ -0,0 +1,239 @@
+package validate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// AddressChecker check the address of a blockchain.
+type AddressChecker func(addr string) bool
+
+// blockchain address checkers, key is the chain symbol. see RegisterAddressChecker()
+var addressCheckers = map[string]AddressChecker{
+	"BTC": func(addr string) bool {
+		return checkBTCAddress(addr, false)
+	},
+	"ETH": checkETHAddress,
+}
+
+// RegisterAddressChecker register or override the address checker of a blockchain.
+//
+// Usage:
+// 	validate.RegisterAddressChecker("LTC", func(addr string) bool {
+// 		// check the litecoin address ...
+// 	})
+func RegisterAddressChecker(chain string, checker AddressChecker) {
+	if chain == "" || checker == nil {
+		configErrorf("blockchain symbol and the checker func cannot be empty")
+		return
+	}
+	addressCheckers[strings.ToUpper(chain)] = checker
+}
+
+const (
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	bech32Charset  = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	// the checksum constants of the bech32 and bech32m(BIP-350)
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// the legacy address version bytes of the bitcoin. P2PKH, P2SH
+var (
+	btcMainVersions = []byte{0x00, 0x05}
+	btcTestVersions = []byte{0x6f, 0xc4}
+)
+
+// check the bitcoin address. support base58check(P2PKH, P2SH) and bech32/bech32m(SegWit) address.
+func checkBTCAddress(addr string, testnet bool) bool {
+	hrp, versions := "bc", btcMainVersions
+	if testnet {
+		hrp, versions = "tb", btcTestVersions
+	}
+
+	if strings.HasPrefix(strings.ToLower(addr), hrp+"1") {
+		return checkSegwitAddress(addr, hrp)
+	}
+
+	payload, ok := base58CheckDecode(addr)
+	if !ok || len(payload) != 21 {
+		return false
+	}
+	return payload[0] == versions[0] || payload[0] == versions[1]
+}
+
+// decode the base58check string, returns the payload(version + data) without the checksum.
+func base58CheckDecode(s string) ([]byte, bool) {
+	raw, ok := base58Decode(s)
+	if !ok || len(raw) < 5 {
+		return nil, false
+	}
+
+	payload, checksum := raw[:len(raw)-4], raw[len(raw)-4:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	return payload, string(second[:4]) == string(checksum)
+}
+
+// decode the base58 string. the leading '1' is the zero byte.
+func base58Decode(s string) ([]byte, bool) {
+	if s == "" {
+		return nil, false
+	}
+
+	// the big-endian number in base 256
+	var num []byte
+	for i := 0; i < len(s); i++ {
+		carry := strings.IndexByte(base58Alphabet, s[i])
+		if carry < 0 {
+			return nil, false
+		}
+
+		for j := len(num) - 1; j >= 0; j-- {
+			carry += int(num[j]) * 58
+			num[j] = byte(carry)
+			carry >>= 8
+		}
+		for ; carry > 0; carry >>= 8 {
+			num = append([]byte{byte(carry)}, num...)
+		}
+	}
+
+	var zeros int
+	for zeros < len(s) && s[zeros] == '1' {
+		zeros++
+	}
+	return append(make([]byte, zeros), num...), true
+}
+
+// check the SegWit address(BIP-173, BIP-350) with the human-readable part.
+func checkSegwitAddress(addr, hrp string) bool {
+	// mixed case is not allowed
+	if addr != strings.ToLower(addr) && addr != strings.ToUpper(addr) {
+		return false
+	}
+
+	addr = strings.ToLower(addr)
+	if len(addr) > 90 || !strings.HasPrefix(addr, hrp+"1") {
+		return false
+	}
+
+	data := make([]byte, 0, len(addr))
+	for _, c := range addr[len(hrp)+1:] {
+		pos := strings.IndexRune(bech32Charset, c)
+		if pos < 0 {
+			return false
+		}
+		data = append(data, byte(pos))
+	}
+
+	// witness version + program + checksum(6)
+	if len(data) < 7 || data[0] > 16 {
+		return false
+	}
+
+	version := data[0]
+	program, ok := convertBits(data[1:len(data)-6], 5, 8)
+	if !ok || len(program) < 2 || len(program) > 40 {
+		return false
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return false
+	}
+
+	// the witness version 0 use bech32, others use bech32m
+	want := uint32(bech32mConst)
+	if version == 0 {
+		want = bech32Const
+	}
+	return bech32Polymod(bech32HrpExpand(hrp), data) == want
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	ret := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		ret = append(ret, hrp[i]>>5)
+	}
+
+	ret = append(ret, 0)
+	for i := 0; i < len(hrp); i++ {
+		ret = append(ret, hrp[i]&31)
+	}
+	return ret
+}
+
+func bech32Polymod(values ...[]byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+	chk := uint32(1)
+	for _, vs := range values {
+		for _, v := range vs {
+			top := chk >> 25
+			chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+			for i := 0; i < 5; i++ {
+				if (top>>uint(i))&1 == 1 {
+					chk ^= gen[i]
+				}
+			}
+		}
+	}
+	return chk
+}
+
+// regroup the bits of the data, without padding. eg: 5 bits groups to 8 bits groups.
+func convertBits(data []byte, from, to uint) ([]byte, bool) {
+	var acc, bits uint
+	maxV := uint(1)<<to - 1
+
+	ret := make([]byte, 0, len(data)*int(from)/int(to))
+	for _, v := range data {
+		acc = acc<<from | uint(v)
+		for bits += from; bits >= to; {
+			bits -= to
+			ret = append(ret, byte(acc>>bits&maxV))
+		}
+	}
+
+	// the remaining bits must be zero padding
+	if bits >= from || (acc<<(to-bits))&maxV != 0 {
+		return nil, false
+	}
+	return ret, true
+}
+
+var rxETHAddress = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// check the ethereum address. the mixed case address will check the EIP-55 checksum.
+func checkETHAddress(addr string) bool {
+	if !rxETHAddress.MatchString(addr) {
+		return false
+	}
+
+	hexPart := addr[2:]
+	lower := strings.ToLower(hexPart)
+	if hexPart == lower || hexPart == strings.ToUpper(hexPart) {
+		return true
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(lower))
+	sum := hex.EncodeToString(hash.Sum(nil))
+
+	// the letter should be upper case when the hash nibble >= 8
+	for i := 0; i < len(hexPart); i++ {
+		c := hexPart[i]
+		if c <= '9' {
+			continue
+		}
+
+		upper := sum[i] >= '8'
+		if upper != (c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
@@ -99,10 +99,18 @@ func (d *MapData) Type() uint8 {
 	return sourceMap
 }
 
-// Set value by key
+// Set value by key. support set value by path, will create intermediate maps.
 func (d *MapData) Set(field string, val interface{}) (interface{}, error) {
-	d.Map[field] = val
-	return val, nil
+	if d.Map == nil {
+		d.Map = make(map[string]interface{})
+	}
+
+	// is top field OR field not contains sub-key
+	if _, ok := d.Map[field]; ok || !strings.ContainsRune(field, '.') {
+		d.Map[field] = val
+		return val, nil
+	}
+	return val, setByPath(d.Map, field, val)
 }
 
 // Get value by key. support get value by path.
@@ -187,8 +195,6 @@ type CustomMessagesFace interface {
 type StructData struct {
 	// source struct data, from user setting
 	src interface{}
-	// max depth for parse sub-struct. TODO WIP ...
-	// depth int
 	// from reflect source Struct
 	value reflect.Value
 	// source struct reflect.Type
@@ -208,6 +214,12 @@ type StructData struct {
 	//
 	// see GlobalOption.ValidateTag
 	ValidateTag string
+	// MaxDepth for parse sub-struct.
+	//
+	// see GlobalOption.MaxDepth
+	MaxDepth int
+	// accepted layouts for parse time string. see Validation.TimeLayouts()
+	timeLayouts []string
 }
 
 // StructOption definition
@@ -222,6 +234,8 @@ var (
 	ftFaceType = reflect.TypeOf(new(FieldTranslatorFace)).Elem()
 	cvFaceType = reflect.TypeOf(new(ConfigValidationFace)).Elem()
 	timeType   = reflect.TypeOf(time.Time{})
+	// time.Duration type
+	durationType = reflect.TypeOf(time.Duration(0))
 )
 
 // Src get
@@ -247,7 +261,9 @@ func (d *StructData) Create(err ...error) *Validation {
 	}
 
 	// collect field filter/validate rules from struct tags
-	d.parseRulesFromTag(v)
+	if err := d.parseRulesFromTag(v); err != nil {
+		return v.WithError(err)
+	}
 
 	// has custom config func
 	if d.valueTpy.Implements(cvFaceType) {
@@ -275,9 +291,15 @@ func (d *StructData) Create(err ...error) *Validation {
 	return v
 }
 
+// visiting struct/map key, use for reference cycle detection.
+type visitKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
 // parse and collect rules from struct tags.
-func (d *StructData) parseRulesFromTag(v *Validation) {
-	var recursiveFunc func(vv reflect.Value, vt reflect.Type, preStrName string, parentIsAnonymous bool)
+func (d *StructData) parseRulesFromTag(v *Validation) (err error) {
+	var recursiveFunc func(vv reflect.Value, vt reflect.Type, preStrName string, parentIsAnonymous bool, depth int)
 	if d.ValidateTag == "" {
 		d.ValidateTag = gOpt.ValidateTag
 	}
@@ -286,13 +308,36 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 		d.FilterTag = gOpt.FilterTag
 	}
 
+	if d.MaxDepth <= 0 {
+		d.MaxDepth = gOpt.MaxDepth
+	}
+
 	fOutMap := make(map[string]string, 0)
+	// the struct/map values on current parsing path.
+	visiting := make(map[visitKey]bool)
 
 	vv := d.value
 	vt := d.valueTpy
 	// preStrName - the parent field name.
-	recursiveFunc = func(vv reflect.Value, vt reflect.Type, parentFName string, parentIsAnonymous bool) {
-		for i := 0; i < vt.NumField(); i++ {
+	recursiveFunc = func(vv reflect.Value, vt reflect.Type, parentFName string, parentIsAnonymous bool, depth int) {
+		if d.MaxDepth > 0 && depth > d.MaxDepth {
+			err = fmt.Errorf("validate: the struct nesting depth exceeds the max depth %d at field '%s'", d.MaxDepth, parentFName)
+			return
+		}
+
+		// check self-referential struct
+		if sv := removeValuePtr(vv); sv.CanAddr() {
+			key := visitKey{addr: sv.UnsafeAddr(), typ: vt}
+			if visiting[key] {
+				err = fmt.Errorf("validate: found a reference cycle at field '%s'", parentFName)
+				return
+			}
+
+			visiting[key] = true
+			defer delete(visiting, key)
+		}
+
+		for i := 0; i < vt.NumField() && err == nil; i++ {
 			fValue := removeValuePtr(vv).Field(i)
 			fv := vt.Field(i)
 			// skip don't exported field
@@ -372,7 +417,7 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 
 				switch ft.Kind() {
 				case reflect.Struct:
-					recursiveFunc(fValue, ft, name, fv.Anonymous)
+					recursiveFunc(fValue, ft, name, fv.Anonymous, depth+1)
 
 				case reflect.Array, reflect.Slice:
 					fValue = removeValuePtr(fValue)
@@ -382,12 +427,24 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 
 						arrayName := fmt.Sprintf("%s.%d", name, j)
 						if elemType.Kind() == reflect.Struct {
-							recursiveFunc(elemValue, elemType, arrayName, fv.Anonymous)
+							recursiveFunc(elemValue, elemType, arrayName, fv.Anonymous, depth+1)
 						}
 					}
 
 				case reflect.Map:
 					fValue = removeValuePtr(fValue)
+					if fValue.IsNil() {
+						continue
+					}
+
+					// check self-referential map
+					mKey := visitKey{addr: fValue.Pointer(), typ: fValue.Type()}
+					if visiting[mKey] {
+						err = fmt.Errorf("validate: found a reference cycle at field '%s'", name)
+						return
+					}
+					visiting[mKey] = true
+
 					for _, key := range fValue.MapKeys() {
 						key = removeValuePtr(key)
 						elemValue := removeValuePtr(fValue.MapIndex(key))
@@ -410,20 +467,24 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 
 						arrayName := fmt.Sprintf(format, name, val)
 						if elemType.Kind() == reflect.Struct {
-							recursiveFunc(elemValue, elemType, arrayName, fv.Anonymous)
+							recursiveFunc(elemValue, elemType, arrayName, fv.Anonymous, depth+1)
 						}
 					}
-
+					delete(visiting, mKey)
 				}
 			}
 		}
 	}
 
-	recursiveFunc(removeValuePtr(vv), vt, "", false)
+	recursiveFunc(removeValuePtr(vv), vt, "", false, 0)
+	if err != nil {
+		return err
+	}
 
 	if len(fOutMap) > 0 {
 		v.Trans().AddFieldMap(fOutMap)
 	}
+	return nil
 }
 
 // eg: `message:"required:name is required|minLen:name min len is %d"`
@@ -642,6 +703,33 @@ func (d *StructData) Set(field string, val interface{}) (newVal interface{}, err
 		return nil, ErrSetValue
 	}
 
+	// string to time.Time
+	if str, ok := val.(string); ok && fv.Type() == timeType {
+		layouts := d.timeLayouts
+		if len(layouts) == 0 {
+			layouts = gOpt.TimeLayouts
+		}
+
+		t, err := parseTime(str, layouts)
+		if err != nil {
+			return nil, err
+		}
+
+		fv.Set(reflect.ValueOf(t))
+		return t, nil
+	}
+
+	// string to time.Duration
+	if str, ok := val.(string); ok && fv.Type() == durationType {
+		dur, err := time.ParseDuration(str)
+		if err != nil {
+			return nil, err
+		}
+
+		fv.Set(reflect.ValueOf(dur))
+		return dur, nil
+	}
+
 	// Notice: need convert value type
 	rftVal := reflect.ValueOf(val)
 
@@ -795,6 +883,8 @@ func (d *FormData) Set(field string, val interface{}) (newVal interface{}, err e
 	switch tpVal := val.(type) {
 	case string:
 		d.Form.Set(field, tpVal)
+	case []string:
+		d.Form[field] = tpVal
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
 		newVal = strutil.MustString(val)
 		d.Form.Set(field, newVal.(string))
@@ -810,7 +900,12 @@ func (d FormData) TryGet(key string) (val interface{}, exist, zero bool) {
 	return
 }
 
-// Get value by key
+// Get value by key.
+//
+// support get value by dot-path from the bracket-notation keys. eg:
+// 	"user.name" -> "user[name]"
+// 	"tags"      -> "tags[]", will return all values([]string)
+// 	"tags.1"    -> "tags[]", will return the second value
 func (d FormData) Get(key string) (interface{}, bool) {
 	// get form value
 	if vs, ok := d.Form[key]; ok && len(vs) > 0 {
@@ -821,6 +916,35 @@ func (d FormData) Get(key string) (interface{}, bool) {
 	if fh, ok := d.Files[key]; ok {
 		return fh, true
 	}
+	return d.getByBracketKey(key)
+}
+
+// get value from the bracket-notation keys. eg: "user[name]", "tags[]"
+func (d FormData) getByBracketKey(key string) (interface{}, bool) {
+	bKey := toBracketKey(key)
+	if bKey != key {
+		if vs, ok := d.Form[bKey]; ok && len(vs) > 0 {
+			return vs[0], true
+		}
+
+		if fh, ok := d.Files[bKey]; ok {
+			return fh, true
+		}
+	}
+
+	// list values. eg: "tags[]"
+	if vs, ok := d.Form[bKey+"[]"]; ok {
+		return vs, true
+	}
+
+	// one of list values. eg: "tags.1"
+	if pos := strings.LastIndexByte(key, '.'); pos > 0 {
+		if idx, err := strconv.Atoi(key[pos+1:]); err == nil && idx >= 0 {
+			if vs := d.Form[toBracketKey(key[:pos])+"[]"]; idx < len(vs) {
+				return vs[idx], true
+			}
+		}
+	}
 	return nil, false
 }
 
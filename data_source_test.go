@@ -5,6 +5,7 @@ import (
 	"mime/multipart"
 	"net/url"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -117,6 +118,47 @@ func TestFormData(t *testing.T) {
 	is.False(d.HasFile("file"))
 }
 
+func TestFormData_bracketKeys(t *testing.T) {
+	is := assert.New(t)
+	d := FromURLValues(url.Values{
+		"user[name]":         {"inhere"},
+		"user[profile][age]": {"30"},
+		"tags[]":             {"go", "php"},
+	})
+	d.AddFile("user[avatar]", &multipart.FileHeader{Filename: "avatar.png"})
+
+	val, ok := d.Get("user.name")
+	is.True(ok)
+	is.Equal("inhere", val)
+	val, ok = d.Get("user.profile.age")
+	is.True(ok)
+	is.Equal("30", val)
+	val, ok = d.Get("tags")
+	is.True(ok)
+	is.Equal([]string{"go", "php"}, val)
+	val, ok = d.Get("tags.1")
+	is.True(ok)
+	is.Equal("php", val)
+	_, ok = d.Get("tags.2")
+	is.False(ok)
+	_, ok = d.Get("user.avatar")
+	is.True(ok)
+	_, ok = d.Get("user.not-exist")
+	is.False(ok)
+
+	v := d.Create()
+	v.StringRule("user.name", "required|minLen:3")
+	v.StringRule("user.profile.age", "required|int|min:18", "int")
+	v.StringRule("tags.*", "required|in:go,php,java")
+	v.StringRule("tags", "required|minLen:2")
+	is.True(v.Validate())
+	is.Equal([]string{"go", "php"}, v.SafeVal("tags"))
+
+	v = d.Create()
+	v.StringRule("tags.*", "in:go,java")
+	is.False(v.Validate())
+}
+
 func TestStructData_Create(t *testing.T) {
 	is := assert.New(t)
 	_, err := FromStruct(time.Now())
@@ -236,3 +278,90 @@ func TestStructData_Get_ptrVal(t *testing.T) {
 	assert.Equal(t, "*int", fmt.Sprintf("%T", val))
 	assert.Equal(t, 0, *val.(*int))
 }
+
+type cycleNode struct {
+	Name string `validate:"required"`
+	Next *cycleNode
+	Subs map[string]cycleNode
+}
+
+func TestStructData_parseRulesFromTag_cycle(t *testing.T) {
+	is := assert.New(t)
+
+	// pointer cycle
+	n1 := &cycleNode{Name: "n1"}
+	n1.Next = &cycleNode{Name: "n2", Next: n1}
+
+	v := Struct(n1)
+	is.False(v.Validate())
+	is.Contains(v.Errors.One(), "found a reference cycle at field 'Next.Next'")
+
+	// map cycle
+	n3 := cycleNode{Name: "n3", Subs: map[string]cycleNode{}}
+	n3.Subs["self"] = n3
+
+	v = Struct(n3)
+	is.False(v.Validate())
+	is.Contains(v.Errors.One(), "found a reference cycle at field 'Subs.self.Subs'")
+
+	// shared, but not a cycle
+	shared := &cycleNode{Name: "shared"}
+	v = Struct(&struct {
+		A, B *cycleNode
+	}{shared, shared})
+	is.True(v.Validate())
+}
+
+func TestStructData_parseRulesFromTag_maxDepth(t *testing.T) {
+	is := assert.New(t)
+
+	root := &cycleNode{Name: "n0"}
+	node := root
+	for i := 1; i <= 5; i++ {
+		node.Next = &cycleNode{Name: "n" + strconv.Itoa(i)}
+		node = node.Next
+	}
+
+	d, err := FromStruct(root)
+	is.NoError(err)
+	d.MaxDepth = 3
+
+	v := d.Create()
+	is.False(v.Validate())
+	is.Contains(v.Errors.One(), "exceeds the max depth 3 at field 'Next.Next.Next.Next'")
+
+	Config(func(opt *GlobalOption) {
+		opt.MaxDepth = 5
+	})
+	defer ResetOption()
+
+	// rules of the deepest node are collected
+	node.Name = ""
+	v = Struct(root)
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Next.Next.Next.Next.Next.Name"))
+}
+
+func TestStructData_Set_timeValue(t *testing.T) {
+	is := assert.New(t)
+	type user struct {
+		Birthday time.Time
+	}
+
+	u := &user{}
+	d, err := FromStruct(u)
+	is.NoError(err)
+
+	_, err = d.Set("Birthday", "2022-02-01")
+	is.NoError(err)
+	is.Equal("2022-02-01", u.Birthday.Format("2006-01-02"))
+
+	// custom layouts
+	d.Create().TimeLayouts("02/01/2006")
+	_, err = d.Set("Birthday", "15/02/2022")
+	is.NoError(err)
+	is.Equal("2022-02-15", u.Birthday.Format("2006-01-02"))
+
+	_, err = d.Set("Birthday", "2022-02-01")
+	is.Error(err)
+}
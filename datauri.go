@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+)
+
+// the options of the data URI rule. see IsDataURI()
+type dataURIOptions struct {
+	mimeTypes []string
+	// the max decoded size in bytes. 0 is unlimited
+	maxSize int
+}
+
+// parse the data URI rule options. the MIME type contains '/', the max size is "maxSize(N)"
+func parseDataURIOptions(options []string) (opt dataURIOptions, ok bool) {
+	for _, name := range options {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == "":
+		case strings.HasPrefix(name, "maxSize(") && strings.HasSuffix(name, ")"):
+			size, err := strconv.Atoi(name[len("maxSize(") : len(name)-1])
+			if err != nil || size <= 0 {
+				configErrorf("invalid data URI option '%s', the max size must be a positive integer", name)
+				return opt, false
+			}
+			opt.maxSize = size
+		case strings.Count(name, "/") == 1:
+			opt.mimeTypes = append(opt.mimeTypes, strings.ToLower(name))
+		default:
+			configErrorf("invalid data URI option '%s', allow: <type/subtype>, maxSize(N)", name)
+			return opt, false
+		}
+	}
+	return opt, true
+}
+
+// parse the data URI: "data:<mime>[;param=value]*;base64,<payload>". see RFC 2397
+// returns the lower case MIME type and the decoded payload size.
+func parseDataURI(s string) (mime string, size int, ok bool) {
+	if len(s) < 5 || !strings.EqualFold(s[:5], "data:") {
+		return "", 0, false
+	}
+
+	pos := strings.IndexByte(s, ',')
+	if pos < 0 {
+		return "", 0, false
+	}
+
+	params := strings.Split(s[5:pos], ";")
+	if len(params) < 2 || !strings.EqualFold(params[len(params)-1], "base64") {
+		return "", 0, false
+	}
+
+	// the MIME type with the parameters. eg: "text/plain;charset=utf-8"
+	if mime, ok = parseMimeType(strings.Join(params[:len(params)-1], ";")); !ok {
+		return "", 0, false
+	}
+
+	// the payload may omit the padding
+	payload := s[pos+1:]
+	enc := base64.StdEncoding
+	if len(payload)%4 != 0 {
+		enc = base64.RawStdEncoding
+	}
+
+	data, err := enc.DecodeString(payload)
+	if err != nil || len(data) == 0 {
+		return "", 0, false
+	}
+	return mime, len(data), true
+}
@@ -0,0 +1,201 @@
+package validate
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DecimalLike is the arbitrary-precision decimal number. eg: shopspring/decimal.Decimal
+//
+// The numeric rules(min, max, between, gt, lt) will compare the decimal value exactly,
+// instead of converting it to float.
+type DecimalLike interface {
+	// Rat returns the exact rational number of the decimal.
+	Rat() *big.Rat
+}
+
+// check the value is a decimal. the nil pointer is not a decimal.
+func isDecimalLike(val interface{}) bool {
+	if _, ok := val.(DecimalLike); !ok {
+		return false
+	}
+
+	rv := reflect.ValueOf(val)
+	return rv.Kind() != reflect.Ptr || !rv.IsNil()
+}
+
+// check the value need the exact compare, it cannot be converted to int64 or float64 without losing precision.
+// eg: DecimalLike, *big.Int, *big.Rat, *big.Float, the uint64 exceeding int64
+func isBigNumber(val interface{}) bool {
+	switch tv := val.(type) {
+	case *big.Int:
+		return tv != nil
+	case *big.Rat:
+		return tv != nil
+	case *big.Float:
+		return tv != nil && !tv.IsInf()
+	}
+
+	if isDecimalLike(val) {
+		return true
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint() > math.MaxInt64
+	}
+	return false
+}
+
+// check the value is an integer string. eg: "-123", "340282366920938463463374607431768211455"
+func isIntString(val interface{}) bool {
+	s, ok := val.(string)
+	if !ok {
+		return false
+	}
+
+	s = strings.TrimSpace(s)
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+	return s != "" && isDigits(s)
+}
+
+// convert the number value to *big.Rat, without losing precision.
+// support: DecimalLike, *big.Int, *big.Rat, *big.Float, int(X), uint(X), float(X) and the numeric string.
+func toBigRat(val interface{}) (*big.Rat, bool) {
+	switch tv := val.(type) {
+	case *big.Int:
+		if tv == nil {
+			return nil, false
+		}
+		return new(big.Rat).SetInt(tv), true
+	case *big.Rat:
+		if tv == nil {
+			return nil, false
+		}
+		return tv, true
+	case *big.Float:
+		if tv == nil || tv.IsInf() {
+			return nil, false
+		}
+		r, _ := tv.Rat(nil)
+		return r, true
+	}
+
+	if dec, ok := val.(DecimalLike); ok {
+		if !isDecimalLike(val) {
+			return nil, false
+		}
+
+		r := dec.Rat()
+		return r, r != nil
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(rv.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, false
+		}
+
+		// use the shortest decimal string of the float. eg: float64(0.1) is "0.1"
+		bitSize := 64
+		if rv.Kind() == reflect.Float32 {
+			bitSize = 32
+		}
+		return new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, bitSize))
+	case reflect.String:
+		s := strings.TrimSpace(rv.String())
+		if s == "" || strings.ContainsRune(s, '/') {
+			return nil, false
+		}
+		return new(big.Rat).SetString(s)
+	}
+	return nil, false
+}
+
+// compare the decimal or big number values exactly. returns `srcVal op(lt,lte,gt,gte) dstVal`?
+func compareDecimal(srcVal, dstVal interface{}, op string) bool {
+	src, ok := toBigRat(srcVal)
+	if !ok {
+		return false
+	}
+
+	dst, ok := toBigRat(dstVal)
+	if !ok {
+		return false
+	}
+	return compareInt64(int64(src.Cmp(dst)), 0, op)
+}
+
+var rxDecimalString = regexp.MustCompile(`^[+-]?(?:\d+\.?\d*|\.\d+)$`)
+
+// count the integer digits and the fractional digits of the decimal value.
+// the leading zeros of the integer part and the trailing zeros of the fractional part are not counted.
+func decimalDigits(val interface{}) (intDigits, fracDigits int, ok bool) {
+	var s string
+	if str, isStr := val.(string); isStr {
+		if s = strings.TrimSpace(str); !rxDecimalString.MatchString(s) {
+			return 0, 0, false
+		}
+	} else {
+		r, isNum := toBigRat(val)
+		if !isNum {
+			return 0, 0, false
+		}
+
+		scale, exact := ratScale(r)
+		if !exact {
+			return 0, 0, false
+		}
+		s = r.FloatString(scale)
+	}
+
+	s = strings.TrimLeft(s, "+-")
+	intPart, fracPart := s, ""
+	if pos := strings.IndexByte(s, '.'); pos >= 0 {
+		intPart, fracPart = s[:pos], s[pos+1:]
+	}
+
+	intPart = strings.TrimLeft(intPart, "0")
+	fracPart = strings.TrimRight(fracPart, "0")
+	return len(intPart), len(fracPart), true
+}
+
+// get the min scale of the rational number as the decimal.
+// returns false on it cannot be a finite decimal. eg: 1/3
+func ratScale(r *big.Rat) (scale int, exact bool) {
+	den := new(big.Int).Set(r.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+
+	var twos, fives int
+	mod := new(big.Int)
+	for den.Cmp(big.NewInt(1)) > 0 {
+		switch {
+		case mod.Mod(den, two).Sign() == 0:
+			den.Quo(den, two)
+			twos++
+		case mod.Mod(den, five).Sign() == 0:
+			den.Quo(den, five)
+			fives++
+		default:
+			return 0, false
+		}
+	}
+
+	if twos > fives {
+		return twos, true
+	}
+	return fives, true
+}
@@ -0,0 +1,153 @@
+package validate
+
+import (
+	"strings"
+	"sync"
+)
+
+// the built-in disposable email domains
+const disposableDomainTable = `
+0-mail.com 10minutemail.com 10minutemail.net 20minutemail.com 33mail.com
+anonbox.net anonymbox.com armyspy.com burnermail.io byom.de
+cuvox.de dayrep.com deadaddress.com discard.email discardmail.com
+discardmail.de dispostable.com dropmail.me einrot.com emailondeck.com
+fakeinbox.com fakemail.net filzmail.com fleckens.hu getairmail.com
+getnada.com grr.la guerrillamail.biz guerrillamail.com guerrillamail.de
+guerrillamail.info guerrillamail.net guerrillamail.org guerrillamailblock.com gustr.com
+harakirimail.com incognitomail.org jetable.org jourrapide.com mailcatch.com
+maildrop.cc mailexpire.com mailforspam.com mailinator.com mailinator.net
+mailinator2.com mailnesia.com mailnull.com mailsac.com mailtemp.info
+mintemail.com mohmal.com moakt.com mt2015.com mytemp.email
+mytrashmail.com nada.email notmailinator.com nwldx.com objectmail.com
+one-time.email sharklasers.com shieldemail.com spam4.me spambog.com
+spambox.us spamgourmet.com spamherelots.com spaml.de spamobox.com
+superrito.com teleworm.us temp-mail.io temp-mail.org tempail.com
+tempinbox.com tempm.com tempmail.dev tempmail.net tempmailo.com
+tempr.email throwawaymail.com tmail.ws tmpmail.net tmpmail.org
+trash-mail.com trashmail.com trashmail.de trashmail.me trashmail.net
+trbvm.com wegwerfmail.de wegwerfmail.net yopmail.com yopmail.fr
+yopmail.net zetmail.com
+`
+
+// DisposableDomainProvider check the email domain is disposable. see SetDisposableDomainProvider()
+type DisposableDomainProvider interface {
+	IsDisposable(domain string) bool
+}
+
+// DomainList is a concurrency safe and updatable domain list, it implements the DisposableDomainProvider.
+//
+// The sub domains of the listed domain are also matched. eg: "a.mailinator.com" matched by "mailinator.com"
+type DomainList struct {
+	mu      sync.RWMutex
+	domains map[string]bool
+}
+
+// NewDomainList create a domain list.
+func NewDomainList(domains ...string) *DomainList {
+	dl := &DomainList{domains: make(map[string]bool, len(domains))}
+	dl.Add(domains...)
+	return dl
+}
+
+// Add domains to the list.
+func (dl *DomainList) Add(domains ...string) {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	for _, domain := range domains {
+		if domain = normalizeDomain(domain); domain != "" {
+			dl.domains[domain] = true
+		}
+	}
+}
+
+// Remove domains from the list.
+func (dl *DomainList) Remove(domains ...string) {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	for _, domain := range domains {
+		delete(dl.domains, normalizeDomain(domain))
+	}
+}
+
+// Reset replace all domains of the list. useful for reload the list from remote.
+func (dl *DomainList) Reset(domains ...string) {
+	list := NewDomainList(domains...)
+
+	dl.mu.Lock()
+	dl.domains = list.domains
+	dl.mu.Unlock()
+}
+
+// Len get the domain count of the list.
+func (dl *DomainList) Len() int {
+	dl.mu.RLock()
+	defer dl.mu.RUnlock()
+	return len(dl.domains)
+}
+
+// Has check the domain or its parent domain is in the list.
+func (dl *DomainList) Has(domain string) bool {
+	domain = normalizeDomain(domain)
+
+	dl.mu.RLock()
+	defer dl.mu.RUnlock()
+
+	for domain != "" {
+		if dl.domains[domain] {
+			return true
+		}
+
+		pos := strings.IndexByte(domain, '.')
+		if pos < 0 {
+			break
+		}
+		domain = domain[pos+1:]
+	}
+	return false
+}
+
+// IsDisposable check the domain is disposable. implements the DisposableDomainProvider
+func (dl *DomainList) IsDisposable(domain string) bool {
+	return dl.Has(domain)
+}
+
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+var (
+	disposableOnce sync.Once
+	disposableList *DomainList
+	// custom disposable domain provider
+	disposableProvider DisposableDomainProvider
+)
+
+// DisposableDomains get the built-in disposable domain list, can add or remove domains of it.
+//
+// Usage:
+// 	validate.DisposableDomains().Add("my-temp-mail.com")
+func DisposableDomains() *DomainList {
+	disposableOnce.Do(func() {
+		disposableList = NewDomainList(strings.Fields(disposableDomainTable)...)
+	})
+	return disposableList
+}
+
+// SetDisposableDomainProvider set the custom disposable domain provider for the emailNotDisposable rule.
+// set nil will reset to the built-in list.
+//
+// Usage:
+// 	validate.SetDisposableDomainProvider(validate.NewDomainList(domains...))
+func SetDisposableDomainProvider(p DisposableDomainProvider) {
+	disposableProvider = p
+}
+
+// check the email domain is disposable by the provider.
+func isDisposableDomain(domain string) bool {
+	if disposableProvider != nil {
+		return disposableProvider.IsDisposable(domain)
+	}
+	return DisposableDomains().IsDisposable(domain)
+}
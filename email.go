@@ -0,0 +1,89 @@
+package validate
+
+import "strings"
+
+// the atext chars of the RFC 5322, exclude letters and digits.
+const emailAtextSpecials = "!#$%&'*+-/=?^_`{|}~"
+
+// check the email address by the RFC 5322 addr-spec grammar. (without the obsolete syntax and comments)
+//
+// 	addr-spec  = local-part "@" domain
+// 	local-part = dot-atom / quoted-string
+// 	domain     = dot-atom / domain-literal
+func checkRFC5322Email(s string) bool {
+	pos := strings.LastIndexByte(s, '@')
+	if pos < 1 || pos == len(s)-1 {
+		return false
+	}
+
+	local, domain := s[:pos], s[pos+1:]
+	if local[0] == '"' {
+		if !isQuotedString(local) {
+			return false
+		}
+	} else if !isDotAtom(local) {
+		return false
+	}
+
+	if domain[0] == '[' {
+		return isDomainLiteral(domain)
+	}
+	return isDotAtom(domain)
+}
+
+// dot-atom = 1*atext *("." 1*atext)
+func isDotAtom(s string) bool {
+	if s == "" || s[0] == '.' || s[len(s)-1] == '.' || strings.Contains(s, "..") {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c != '.' && !isEmailAtext(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isEmailAtext(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		strings.IndexByte(emailAtextSpecials, c) >= 0
+}
+
+// quoted-string = DQUOTE *([FWS] qcontent) [FWS] DQUOTE. qcontent = qtext / quoted-pair
+func isQuotedString(s string) bool {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return false
+	}
+
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			// quoted-pair = "\" (VCHAR / WSP)
+			if i++; i >= len(s)-1 || s[i] < 32 && s[i] != '\t' || s[i] > 126 {
+				return false
+			}
+		case c == '"':
+			return false
+		case c == ' ' || c == '\t':
+		case c < 33 || c > 126:
+			return false
+		}
+	}
+	return true
+}
+
+// domain-literal = "[" *dtext "]". dtext is printable ASCII except "[", "]", "\"
+func isDomainLiteral(s string) bool {
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return false
+	}
+
+	for i := 1; i < len(s)-1; i++ {
+		if c := s[i]; c < 33 || c > 126 || c == '[' || c == ']' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
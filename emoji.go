@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"strings"
+	"unicode"
+)
+
+// the chars have the Emoji_Presentation property, they are displayed as emoji by default.
+// the Extended_Pictographic chars in the supplementary planes are emoji presentation.
+var emojiPresentationTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23EC, Stride: 1},
+		{Lo: 0x23F0, Hi: 0x23F0, Stride: 1},
+		{Lo: 0x23F3, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x25FD, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267F, Hi: 0x267F, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26A1, Hi: 0x26A1, Stride: 1},
+		{Lo: 0x26AA, Hi: 0x26AB, Stride: 1},
+		{Lo: 0x26BD, Hi: 0x26BE, Stride: 1},
+		{Lo: 0x26C4, Hi: 0x26C5, Stride: 1},
+		{Lo: 0x26CE, Hi: 0x26CE, Stride: 1},
+		{Lo: 0x26D4, Hi: 0x26D4, Stride: 1},
+		{Lo: 0x26EA, Hi: 0x26EA, Stride: 1},
+		{Lo: 0x26F2, Hi: 0x26F3, Stride: 1},
+		{Lo: 0x26F5, Hi: 0x26F5, Stride: 1},
+		{Lo: 0x26FA, Hi: 0x26FA, Stride: 1},
+		{Lo: 0x26FD, Hi: 0x26FD, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270A, Hi: 0x270B, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274C, Hi: 0x274C, Stride: 1},
+		{Lo: 0x274E, Hi: 0x274E, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27B0, Hi: 0x27B0, Stride: 1},
+		{Lo: 0x27BF, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+	},
+}
+
+const (
+	// the emoji presentation selector. eg: "❤" + VS16 is "❤️"
+	emojiVS16 = 0xFE0F
+	// the combining enclosing keycap. eg: "1️⃣"
+	keycapMark = 0x20E3
+)
+
+// check the grapheme cluster is displayed as an emoji.
+// - has the char with the Emoji_Presentation property. eg: "😀", "👍🏽"
+// - the Extended_Pictographic char with the emoji presentation selector. eg: "❤️"
+// - the flag(regional indicators) and the keycap sequence. eg: "🇨🇳", "1️⃣"
+func isEmojiCluster(cluster string) bool {
+	var prevPict bool
+	for _, r := range cluster {
+		switch {
+		case r >= 0x1F000 && unicode.Is(extPictTable, r), unicode.Is(emojiPresentationTable, r):
+			return true
+		case r >= 0x1F1E6 && r <= 0x1F1FF, r == keycapMark:
+			return true
+		case r == emojiVS16 && prevPict:
+			return true
+		}
+		prevPict = unicode.Is(extPictTable, r)
+	}
+	return false
+}
+
+// check the string contains the emoji.
+func hasEmoji(s string) (found bool) {
+	eachGrapheme(s, func(cluster string) {
+		if !found && isEmojiCluster(cluster) {
+			found = true
+		}
+	})
+	return
+}
+
+// StripEmoji filter, remove the emoji from the string. the whole emoji sequence is removed,
+// include the skin tone modifiers, ZWJ and the presentation selectors.
+//
+// Usage:
+// 	v.FilterRule("legalName", "stripEmoji|trim")
+func StripEmoji(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	eachGrapheme(s, func(cluster string) {
+		if !isEmojiCluster(cluster) {
+			sb.WriteString(cluster)
+		}
+	})
+	return sb.String()
+}
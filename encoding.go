@@ -0,0 +1,50 @@
+package validate
+
+import (
+	"encoding/base32"
+	"strings"
+)
+
+// decode the base32 string of the RFC 4648 standard alphabet, the padding is optional.
+func base32Decode(s string) ([]byte, bool) {
+	if s == "" {
+		return nil, false
+	}
+
+	enc := base32.StdEncoding
+	if !strings.HasSuffix(s, "=") && len(s)%8 != 0 {
+		enc = base32.StdEncoding.WithPadding(base32.NoPadding)
+	}
+
+	data, err := enc.DecodeString(s)
+	return data, err == nil
+}
+
+// check the hex string with the "0x" prefix, returns the hex digits.
+func trimHexPrefix(s string) (string, bool) {
+	if len(s) < 3 || s[0] != '0' || s[1] != 'x' && s[1] != 'X' {
+		return "", false
+	}
+
+	digits := s[2:]
+	for i := 0; i < len(digits); i++ {
+		c := digits[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return "", false
+		}
+	}
+	return digits, true
+}
+
+// check the decoded length equals the expected length. the expected length is optional.
+func isDecodedLen(n int, length []int) bool {
+	if len(length) == 0 {
+		return true
+	}
+
+	if length[0] <= 0 {
+		configErrorf("invalid decoded length '%d', it must be a positive integer", length[0])
+		return false
+	}
+	return n == length[0]
+}
@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/gookit/goutil/strutil"
+)
+
+var (
+	enumMu sync.RWMutex
+	// the registered enum sets. see RegisterEnum()
+	enumSets = make(map[string][]string)
+)
+
+// RegisterEnum register the enum set by name, use it by the name in the "enum" and "notEnum" rules.
+// the single arg of them is always the registered name, the "in", "notIn" rules use the literal values.
+// register the nil values to remove it, register an existing name again is a config error.
+//
+// Usage:
+// 	validate.RegisterEnum("orderStatus", []interface{}{"new", "paid", "shipped"})
+// 	v.StringRule("status", "required|enum:orderStatus")
+func RegisterEnum(name string, values []interface{}) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		configErrorf("the enum name is required")
+		return
+	}
+
+	if values == nil {
+		enumMu.Lock()
+		delete(enumSets, name)
+		enumMu.Unlock()
+		return
+	}
+
+	ss := make([]string, len(values))
+	for i, val := range values {
+		ss[i] = strutil.MustString(val)
+	}
+
+	enumMu.Lock()
+	_, exists := enumSets[name]
+	if !exists {
+		enumSets[name] = ss
+	}
+	enumMu.Unlock()
+
+	if exists {
+		configErrorf("the enum %q has been registered", name)
+	}
+}
+
+// EnumValues get the values of the registered enum set.
+func EnumValues(name string) (values []string, ok bool) {
+	enumMu.RLock()
+	values, ok = enumSets[name]
+	enumMu.RUnlock()
+	return
+}
+
+// the rule names use the registered enum set by the single arg. eg: "enum:orderStatus"
+// the "in", "notIn" rules always use the literal values.
+var enumRuleNames = map[string]bool{"enum": true, "notEnum": true, "not_enum": true}
+
+// get the registered enum set name of the rule args, it is decided by the input validator name.
+// the multi args are the literal values. eg: "enum:a,b"
+func enumSetName(validator string, args []interface{}) string {
+	if !enumRuleNames[validator] || len(args) != 1 {
+		return ""
+	}
+
+	switch tv := args[0].(type) {
+	case string:
+		return tv
+	case []string:
+		if len(tv) == 1 {
+			return tv[0]
+		}
+	}
+	return ""
+}
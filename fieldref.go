@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gookit/goutil/arrutil"
+	"github.com/gookit/goutil/strutil"
+)
+
+// the prefix of the field reference arg. eg: "max:@limit"
+// the arg is always a reference on the rule is parsed, the missing field fails the rule.
+//
+// use "@@" for the literal "@". eg: "endsWith:@@corp.com" -> "@corp.com"
+const fieldRefPrefix = "@"
+
+// fieldRef the field reference in the rule args. see parseFieldRefs()
+type fieldRef struct {
+	name string
+	// the single item list arg, it is parsed by the "enum", "notIn" rules. eg: "in:@statuses"
+	isList bool
+}
+
+// String the reference as it is written in the rule. eg: "@limit"
+func (ref fieldRef) String() string {
+	return fieldRefPrefix + ref.name
+}
+
+// parse the field references in the rule args, the "@@" is unescaped to the literal "@".
+// the single "@" is a literal. eg: "startsWith:@"
+func parseFieldRefs(args []interface{}) []interface{} {
+	parsed := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch tv := arg.(type) {
+		case string:
+			if name, ok := fieldRefName(tv); ok {
+				parsed[i] = fieldRef{name: name}
+			} else {
+				parsed[i] = unescapeFieldRef(tv)
+			}
+		case []string:
+			if len(tv) == 1 {
+				if name, ok := fieldRefName(tv[0]); ok {
+					parsed[i] = fieldRef{name: name, isList: true}
+					continue
+				}
+			}
+
+			ss := make([]string, len(tv))
+			for j, item := range tv {
+				ss[j] = unescapeFieldRef(item)
+			}
+			parsed[i] = ss
+		default:
+			parsed[i] = arg
+		}
+	}
+	return parsed
+}
+
+// get the referenced field name of the arg. eg: "@limit" -> "limit"
+func fieldRefName(arg string) (string, bool) {
+	if len(arg) < 2 || !strings.HasPrefix(arg, fieldRefPrefix) || strings.HasPrefix(arg[1:], fieldRefPrefix) {
+		return "", false
+	}
+	return arg[1:], true
+}
+
+// unescape the literal "@" arg. "@@abc" -> "@abc"
+func unescapeFieldRef(arg string) string {
+	if strings.HasPrefix(arg, fieldRefPrefix+fieldRefPrefix) {
+		return arg[1:]
+	}
+	return arg
+}
+
+// resolve the field references in the rule args by the current(filtered) value of the field,
+// and the registered enum set of the "enum", "notEnum" rules. see RegisterEnum()
+// returns a copy on there are references, the rule arguments are not modified.
+// missing is the first referenced field that does not exist in the data.
+func (r *Rule) resolveArgs(v *Validation) (args []interface{}, missing string) {
+	if r.enumName != "" {
+		values, _ := EnumValues(r.enumName)
+		return []interface{}{values}, ""
+	}
+
+	for i, arg := range r.arguments {
+		ref, ok := arg.(fieldRef)
+		if !ok {
+			continue
+		}
+
+		if args == nil {
+			args = make([]interface{}, len(r.arguments))
+			copy(args, r.arguments)
+		}
+
+		val, exist, isDefault := v.GetWithDefault(ref.name)
+		if !exist && !isDefault {
+			if missing == "" {
+				missing = ref.name
+			}
+			continue
+		}
+
+		if ref.isList {
+			args[i] = refValueAsList(val)
+		} else {
+			args[i] = val
+		}
+	}
+
+	if args == nil {
+		return r.arguments, ""
+	}
+	return args, missing
+}
+
+// convert the referenced value to the list arg, like the args of the "enum", "notIn" rules.
+func refValueAsList(val interface{}) interface{} {
+	if val == nil {
+		return []string{}
+	}
+
+	if kind := reflect.TypeOf(val).Kind(); kind != reflect.Slice && kind != reflect.Array {
+		return []string{strutil.MustString(val)}
+	}
+	if ss, err := arrutil.ToStrings(val); err == nil {
+		return ss
+	}
+	return val
+}
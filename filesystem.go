@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"os"
+)
+
+// FileSystem is the file system used by the fileExists, dirExists, pathReadable and pathWritable rules.
+// see SetFileSystem()
+type FileSystem interface {
+	// Stat returns the file info, the symlinks are followed.
+	Stat(name string) (os.FileInfo, error)
+	// Readable check the file or dir can be read.
+	Readable(name string) bool
+	// Writable check the file can be written, or the files can be created in the dir.
+	Writable(name string) bool
+}
+
+// OSFileSystem is the FileSystem of the local OS. it is the default FileSystem.
+type OSFileSystem struct{}
+
+// Stat returns the file info. implements the FileSystem
+func (OSFileSystem) Stat(name string) (os.FileInfo, error) {
+	return os.Stat(name)
+}
+
+// Readable check the regular file or dir can be opened for reading. implements the FileSystem
+func (OSFileSystem) Readable(name string) bool {
+	// the other file types(eg: fifo, device) may block on opening
+	if !isFileOrDir(name) {
+		return false
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return false
+	}
+	return f.Close() == nil
+}
+
+// Writable check the regular file can be written, or the files can be created in the dir by the permissions.
+// nothing is written or created. implements the FileSystem
+func (OSFileSystem) Writable(name string) bool {
+	return isFileOrDir(name) && canWrite(name)
+}
+
+// check the path is an existing regular file or dir, the symlinks are followed.
+func isFileOrDir(name string) bool {
+	fi, err := os.Stat(name)
+	return err == nil && (fi.Mode().IsRegular() || fi.IsDir())
+}
+
+// the file system for the fs rules
+var fileSystem FileSystem = OSFileSystem{}
+
+// SetFileSystem set the file system for the fileExists, dirExists, pathReadable and pathWritable rules.
+// set nil will reset to the OSFileSystem.
+//
+// Usage:
+// 	// go 1.16+, eg: use the fstest.MapFS in tests
+// 	validate.SetFileSystem(validate.FromFS(fstest.MapFS{...}))
+func SetFileSystem(fsys FileSystem) {
+	if fsys == nil {
+		fsys = OSFileSystem{}
+	}
+	fileSystem = fsys
+}
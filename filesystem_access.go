@@ -0,0 +1,24 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package validate
+
+import (
+	"os"
+	"syscall"
+)
+
+// the access(2) modes
+const (
+	accessWrite = 0x2
+	accessExec  = 0x1
+)
+
+// check the file can be written, or the files can be created in the dir by the access(2) of the current user.
+func canWrite(name string) bool {
+	mode := uint32(accessWrite)
+	if fi, err := os.Stat(name); err == nil && fi.IsDir() {
+		mode |= accessExec
+	}
+	return syscall.Access(name, mode) == nil
+}
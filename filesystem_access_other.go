@@ -0,0 +1,14 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package validate
+
+import (
+	"os"
+)
+
+// check the file or dir is writable by the owner write permission. eg: the read-only attribute on Windows.
+func canWrite(name string) bool {
+	fi, err := os.Stat(name)
+	return err == nil && fi.Mode().Perm()&0200 != 0
+}
@@ -0,0 +1,24 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package validate
+
+import (
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOSFileSystem_fifo(t *testing.T) {
+	is := assert.New(t)
+
+	fifo := filepath.Join(t.TempDir(), "pipe")
+	is.NoError(syscall.Mkfifo(fifo, 0644))
+
+	// not blocked on opening
+	is.False(PathReadable(fifo))
+	is.False(PathWritable(fifo))
+	is.True(IsFilePath(fifo))
+}
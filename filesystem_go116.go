@@ -0,0 +1,46 @@
+//go:build go1.16
+// +build go1.16
+
+package validate
+
+import (
+	"io/fs"
+	"os"
+	"path"
+)
+
+// FromFS create the FileSystem from the fs.FS, eg: fstest.MapFS, embed.FS, os.DirFS()
+// the name is cleaned and converted to the fs.FS path, the leading "/" is removed.
+//
+// The fs.FS is read-only, the file is writable when its mode has the owner write permission.
+func FromFS(fsys fs.FS) FileSystem {
+	return ioFS{fsys: fsys}
+}
+
+type ioFS struct {
+	fsys fs.FS
+}
+
+func (f ioFS) path(name string) string {
+	if name = path.Clean("/" + name)[1:]; name == "" {
+		return "."
+	}
+	return name
+}
+
+func (f ioFS) Stat(name string) (os.FileInfo, error) {
+	return fs.Stat(f.fsys, f.path(name))
+}
+
+func (f ioFS) Readable(name string) bool {
+	file, err := f.fsys.Open(f.path(name))
+	if err != nil {
+		return false
+	}
+	return file.Close() == nil
+}
+
+func (f ioFS) Writable(name string) bool {
+	fi, err := f.Stat(name)
+	return err == nil && fi.Mode().Perm()&0200 != 0
+}
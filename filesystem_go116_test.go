@@ -0,0 +1,44 @@
+//go:build go1.16
+// +build go1.16
+
+package validate
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromFS(t *testing.T) {
+	is := assert.New(t)
+
+	SetFileSystem(FromFS(fstest.MapFS{
+		"etc/app.yaml":  {Data: []byte("debug: true"), Mode: 0644},
+		"etc/ro.yaml":   {Data: []byte("debug: false"), Mode: 0444},
+		"var/cache/x":   {Data: []byte("x"), Mode: 0644},
+		"var/cache/dir": {Mode: fs.ModeDir | 0755},
+	}))
+	defer SetFileSystem(nil)
+
+	is.True(IsFilePath("/etc/app.yaml"))
+	is.True(IsFilePath("etc/app.yaml"))
+	is.True(IsFilePath("./etc/../etc/app.yaml"))
+	is.False(IsFilePath("/etc"))
+	is.False(IsFilePath("/etc/not-exist.yaml"))
+	is.True(IsDirPath("/etc"))
+	is.True(IsDirPath("/var/cache/dir"))
+	is.True(IsDirPath("/"))
+	is.False(IsDirPath("/etc/app.yaml"))
+
+	is.True(PathReadable("/etc/app.yaml"))
+	is.True(PathReadable("/etc"))
+	is.False(PathReadable("/etc/not-exist.yaml"))
+	is.True(PathWritable("/etc/app.yaml"))
+	is.False(PathWritable("/etc/ro.yaml"))
+	is.True(PathWritable("/var/cache/dir"))
+
+	is.Nil(Val("/etc/app.yaml", "fileExists|pathReadable"))
+	is.Equal("input value should be a writable path", Val("/etc/ro.yaml", "pathWritable").Error())
+}
@@ -1,10 +1,14 @@
 package validate
 
 import (
+	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/gookit/filter"
+	"github.com/gookit/goutil/arrutil"
 )
 
 /*************************************************************
@@ -12,7 +16,21 @@ import (
  *************************************************************/
 
 var (
-	filterValues map[string]reflect.Value
+	// built in filters of the package. the common filters are provided by the gookit/filter
+	filterValues = map[string]reflect.Value{
+		"toE164":     reflect.ValueOf(ToE164),
+		"toPunycode": reflect.ValueOf(ToPunycode),
+		"slugify":    reflect.ValueOf(Slugify),
+		"nfc":        reflect.ValueOf(ToNFC),
+		"nfkc":       reflect.ValueOf(ToNFKC),
+		// locale case
+		"lowerLocale": reflect.ValueOf(ToLowerLocale),
+		"upperLocale": reflect.ValueOf(ToUpperLocale),
+		// html
+		"sanitizeHTML": reflect.ValueOf(SanitizeHTML),
+		// emoji
+		"stripEmoji": reflect.ValueOf(StripEmoji),
+	}
 )
 
 // AddFilters add global filters
@@ -28,7 +46,9 @@ func AddFilter(name string, filterFunc interface{}) {
 		filterValues = make(map[string]reflect.Value)
 	}
 
-	filterValues[name] = checkFilterFunc(name, filterFunc)
+	if fv := checkFilterFunc(name, filterFunc); fv.IsValid() {
+		filterValues[name] = fv
+	}
 }
 
 /*************************************************************
@@ -49,7 +69,9 @@ func (v *Validation) AddFilter(name string, filterFunc interface{}) {
 	}
 
 	// v.filterFuncs[name] = filterFunc
-	v.filterValues[name] = checkFilterFunc(name, filterFunc)
+	if fv := checkFilterFunc(name, filterFunc); fv.IsValid() {
+		v.filterValues[name] = fv
+	}
 }
 
 // FilterFuncValue get filter by name
@@ -72,14 +94,15 @@ func (v *Validation) FilterFuncValue(name string) reflect.Value {
 // 	v.FilterRule("age", "int")
 func (v *Validation) FilterRule(field string, rule string) *FilterRule {
 	rule = strings.TrimSpace(rule)
-	rules := stringSplit(strings.Trim(rule, "|:"), "|")
+	rules := splitRule(strings.Trim(rule, "|:"), '|')
 	fields := stringSplit(field, ",")
 
+	r := newFilterRule(fields)
 	if len(fields) == 0 || len(rules) == 0 {
-		panicf("no enough arguments or contains invalid argument for add filter rule")
+		configErrorf("no enough arguments or contains invalid argument for add filter rule")
+		return r // on NoPanic=true, the rule will not be added.
 	}
 
-	r := newFilterRule(fields)
 	r.AddFilters(rules...)
 	v.filterRules = append(v.filterRules, r)
 
@@ -94,6 +117,53 @@ func (v *Validation) FilterRules(rules map[string]string) *Validation {
 	return v
 }
 
+// DeriveFunc compute the value of the derived field, the filtered values can be get by v.Get()
+type DeriveFunc func(v *Validation) (interface{}, error)
+
+type derivedField struct {
+	name string
+	fn   DeriveFunc
+}
+
+// DeriveField add a derived field to the data. it is computed after the filter rules
+// in the order of added, the later rules can validate it and v.SafeData() exposes it.
+// the error returned by fn is reported as the filter error of the field.
+//
+// Usage:
+// 	v.DeriveField("fullName", func(v *validate.Validation) (interface{}, error) {
+// 		first, _ := v.Get("first")
+// 		last, _ := v.Get("last")
+// 		return fmt.Sprint(first, " ", last), nil
+// 	})
+// 	v.StringRule("fullName", "required|maxLen:50")
+func (v *Validation) DeriveField(name string, fn DeriveFunc) *Validation {
+	name = strings.TrimSpace(name)
+	if name == "" || fn == nil {
+		configErrorf("the derived field name and func are required")
+		return v
+	}
+
+	v.derivedFields = append(v.derivedFields, &derivedField{name: name, fn: fn})
+	return v
+}
+
+// compute the derived fields, save to the filtered and safe data.
+func (v *Validation) applyDerivedFields() {
+	for _, df := range v.derivedFields {
+		val, err := df.fn(v)
+		if err != nil {
+			v.addFilterError(df.name, "derive", err)
+			if v.StopOnError {
+				return
+			}
+			continue
+		}
+
+		v.filteredData[df.name] = val
+		v.safeData[df.name] = val
+	}
+}
+
 /*************************************************************
  * filtering rule
  *************************************************************/
@@ -139,7 +209,7 @@ func (r *FilterRule) AddFilters(filters ...string) *FilterRule {
 // Apply rule for the rule fields
 func (r *FilterRule) Apply(v *Validation) (err error) {
 	// filter field value
-	for _, field := range r.Fields() {
+	for _, field := range v.expandFilterFields(r.Fields()) {
 		val, exist, zero := v.tryGet(field)
 		if !exist || zero {
 			defVal, ok := v.GetDefValue(field)
@@ -167,18 +237,21 @@ func (r *FilterRule) Apply(v *Validation) (err error) {
 			exist = true
 		}
 
-		// call filters
+		// call filters. the filter error is reported as the field error.
+		var filterErr error
 		for i, name := range r.filters {
-			fv := v.FilterFuncValue(name)
-			args := parseArgString(r.filterArgs[i])
-			if !fv.IsValid() { // is built int filters
-				val, err = filter.Apply(name, val, args)
-			} else {
-				val, err = callCustomFilter(fv, val, args)
+			val, filterErr = v.applyFilter(name, val, parseArgString(r.filterArgs[i]))
+			if filterErr != nil {
+				v.addFilterError(field, name, filterErr)
+				break
 			}
-			if err != nil {
-				return err
+		}
+
+		if filterErr != nil {
+			if v.StopOnError {
+				return nil
 			}
+			continue
 		}
 
 		// update source data field value
@@ -193,12 +266,175 @@ func (r *FilterRule) Apply(v *Validation) (err error) {
 	return
 }
 
+// add the filter error for the field. the message can be customized by the key "_filter" or "field._filter",
+// the "{filter}" and "{error}" in the message will be replaced.
+func (v *Validation) addFilterError(field, filterName string, err error) {
+	msg := v.trans.Message(filterError, field)
+	msg = strings.NewReplacer("{filter}", filterName, "{error}", err.Error()).Replace(msg)
+	v.AddError(field, filterError, msg)
+}
+
 // Fields name get
 func (r *FilterRule) Fields() []string {
 	return r.fields
 }
 
-func callCustomFilter(fv reflect.Value, val interface{}, args []string) (interface{}, error) {
+// the built-in filters for the whole slice value, they are not applied to each element.
+var sliceFilters = []string{"unique", "trimStrings", "stringsToInts"}
+
+// apply the filter to the value. the scalar filters(eg: "trim") are applied to
+// each element of the slice and map recursively.
+func (v *Validation) applyFilter(name string, val interface{}, args []string) (interface{}, error) {
+	fv := v.FilterFuncValue(name)
+	if isElementFilter(fv, name) {
+		switch tv := val.(type) {
+		case []string:
+			list := make([]interface{}, len(tv))
+			for i, item := range tv {
+				list[i] = item
+			}
+
+			newList, err := v.applyFilterEach(name, list, args)
+			if err != nil {
+				return nil, err
+			}
+			if ss, ok := interfacesToStrings(newList); ok {
+				return ss, nil
+			}
+			return newList, nil
+		case []interface{}:
+			return v.applyFilterEach(name, tv, args)
+		case map[string]interface{}:
+			mp := make(map[string]interface{}, len(tv))
+			for key, item := range tv {
+				newVal, err := v.applyFilter(name, item, args)
+				if err != nil {
+					return nil, err
+				}
+				mp[key] = newVal
+			}
+			return mp, nil
+		}
+	}
+
+	if !fv.IsValid() { // is built int filters
+		return filter.Apply(name, val, args)
+	}
+	return callCustomFilter(fv, val, args)
+}
+
+func (v *Validation) applyFilterEach(name string, list []interface{}, args []string) ([]interface{}, error) {
+	newList := make([]interface{}, len(list))
+	for i, item := range list {
+		newVal, err := v.applyFilter(name, item, args)
+		if err != nil {
+			return nil, err
+		}
+		newList[i] = newVal
+	}
+	return newList, nil
+}
+
+// check the filter is for the scalar value, it can be applied to each element of the slice and map.
+// the custom filter func accept the interface{}, slice or map value is for the whole value.
+func isElementFilter(fv reflect.Value, name string) bool {
+	if !fv.IsValid() {
+		return !arrutil.StringsHas(sliceFilters, filter.Name(name))
+	}
+
+	ft := fv.Type()
+	if ft.NumIn() == 0 {
+		return false
+	}
+
+	switch ft.In(0).Kind() {
+	case reflect.Interface, reflect.Slice, reflect.Array, reflect.Map:
+		return false
+	}
+	return true
+}
+
+func interfacesToStrings(list []interface{}) ([]string, bool) {
+	ss := make([]string, len(list))
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		ss[i] = s
+	}
+	return ss, true
+}
+
+// expand the filter fields. the "tags.*" is the whole slice, the filters are applied to each element.
+// the wildcard in the path are expanded by the keys of the slice and map.
+// eg: "users.*.name" -> "users.0.name", "users.1.name"
+func (v *Validation) expandFilterFields(fields []string) []string {
+	var expanded []string
+	for _, field := range fields {
+		field = strings.TrimSuffix(field, ".*")
+		expanded = append(expanded, v.expandPath(field)...)
+	}
+	return expanded
+}
+
+func (v *Validation) expandPath(path string) []string {
+	keys := strings.Split(path, ".")
+	idx := -1
+	for i, key := range keys {
+		if key == "*" {
+			idx = i
+			break
+		}
+	}
+
+	// no wildcard. the top-level wildcard is not supported
+	if idx <= 0 {
+		return []string{path}
+	}
+
+	prefix := strings.Join(keys[:idx], ".")
+	rest := strings.Join(keys[idx+1:], ".")
+	val, ok := v.Get(prefix)
+	if !ok {
+		return nil
+	}
+
+	var subKeys []string
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			subKeys = append(subKeys, strconv.Itoa(i))
+		}
+	case reflect.Map:
+		for _, key := range rv.MapKeys() {
+			subKeys = append(subKeys, fmt.Sprint(key.Interface()))
+		}
+		sort.Strings(subKeys)
+	}
+
+	var paths []string
+	for _, key := range subKeys {
+		sub := prefix + "." + key
+		if rest != "" {
+			sub += "." + rest
+		}
+		paths = append(paths, v.expandPath(sub)...)
+	}
+	return paths
+}
+
+func callCustomFilter(fv reflect.Value, val interface{}, args []string) (newVal interface{}, err error) {
+	// recover the panic on call filter func. eg: the reflect.Call arguments type mismatch.
+	defer func() {
+		if re := recover(); re != nil {
+			given := append([]interface{}{val}, strings2Args(args)...)
+			err = fmt.Errorf("filter func panic: %v, given args %s, want %s", re, typesString(given), fv.Type().String())
+			logf("%s", err.Error())
+		}
+	}()
+
 	var rs []reflect.Value
 	if len(args) > 0 {
 		rs = CallByValue(fv, buildArgs(val, strings2Args(args))...)
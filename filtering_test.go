@@ -109,7 +109,7 @@ func TestAddFilter(t *testing.T) {
 	})
 	v.Filtering()
 	is.True(v.IsFail())
-	is.Contains(v.Errors, "_filter")
+	is.Contains(v.Errors.Field("name"), "_filter")
 
 	v = New(url.Values{
 		"age": {"invalid"},
@@ -122,7 +122,7 @@ func TestAddFilter(t *testing.T) {
 	})
 	v.Filtering()
 	is.True(v.IsFail())
-	is.Equal("report a error", v.Errors.FieldOne("_filter"))
+	is.Contains(v.Errors.FieldOne("age"), "report a error")
 }
 
 // check panic caused nil value with custom filter
@@ -0,0 +1,375 @@
+package validate
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// card brand definition
+type cardBrand struct {
+	name string
+	// the number prefix ranges. eg: {"51", "55"}, {"4", "4"}
+	ranges [][2]string
+	// allowed number lengths
+	lengths []int
+}
+
+// the card brands, the order is the detect priority.
+var cardBrands = []cardBrand{
+	{name: "amex", ranges: [][2]string{{"34", "34"}, {"37", "37"}}, lengths: []int{15}},
+	{name: "diners", ranges: [][2]string{{"300", "305"}, {"36", "36"}, {"38", "39"}}, lengths: []int{14, 15, 16, 17, 18, 19}},
+	{name: "jcb", ranges: [][2]string{{"3528", "3589"}}, lengths: []int{16, 17, 18, 19}},
+	{name: "mir", ranges: [][2]string{{"2200", "2204"}}, lengths: []int{16, 17, 18, 19}},
+	{name: "mastercard", ranges: [][2]string{{"51", "55"}, {"2221", "2720"}}, lengths: []int{16}},
+	{name: "discover", ranges: [][2]string{{"6011", "6011"}, {"644", "649"}, {"65", "65"}}, lengths: []int{16, 17, 18, 19}},
+	{name: "unionpay", ranges: [][2]string{{"62", "62"}, {"81", "81"}}, lengths: []int{16, 17, 18, 19}},
+	{name: "maestro", ranges: [][2]string{{"5018", "5018"}, {"5020", "5020"}, {"5038", "5038"}, {"5893", "5893"},
+		{"6304", "6304"}, {"6759", "6759"}, {"6761", "6763"}}, lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{name: "visa", ranges: [][2]string{{"4", "4"}}, lengths: []int{13, 16, 19}},
+}
+
+// the alias names of the card brands
+var cardBrandAliases = map[string]string{
+	"mc":              "mastercard",
+	"americanexpress": "amex",
+	"dinersclub":      "diners",
+	"cup":             "unionpay",
+}
+
+// CardBrand detect the brand of the card number. returns empty string if not matched.
+// brands: visa, mastercard, amex, discover, jcb, diners, unionpay, maestro, mir
+//
+// Usage:
+// 	CardBrand("4111 1111 1111 1111") // "visa"
+func CardBrand(s string) string {
+	num := cleanCardNumber(s)
+	if num == "" {
+		return ""
+	}
+	return detectCardBrand(num)
+}
+
+func isCardBrandName(name string) bool {
+	for _, brand := range cardBrands {
+		if brand.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func detectCardBrand(num string) string {
+	for _, brand := range cardBrands {
+		if !inCardRanges(num, brand.ranges) {
+			continue
+		}
+
+		for _, n := range brand.lengths {
+			if n == len(num) {
+				return brand.name
+			}
+		}
+	}
+	return ""
+}
+
+// check the number prefix is in the ranges. the prefix digits is same as the range bound.
+func inCardRanges(num string, ranges [][2]string) bool {
+	for _, rg := range ranges {
+		if len(num) < len(rg[0]) {
+			continue
+		}
+
+		prefix := num[:len(rg[0])]
+		if prefix >= rg[0] && prefix <= rg[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// remove the spaces and dashes in the card number. returns empty string if contains other chars.
+func cleanCardNumber(s string) string {
+	var sb strings.Builder
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			sb.WriteRune(c)
+		case c == ' ' || c == '-':
+		default:
+			return ""
+		}
+	}
+	return sb.String()
+}
+
+// check the digits string by the Luhn algorithm.
+func luhnCheck(digits string) bool {
+	if digits == "" {
+		return false
+	}
+
+	var sum int
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		n := int(digits[i] - '0')
+		if n < 0 || n > 9 {
+			return false
+		}
+
+		if double {
+			if n *= 2; n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// build the message params for the credit card. "{brand}" is the detected card brand.
+func creditCardMessageParams(val interface{}, _ []interface{}) map[string]string {
+	str, _ := val.(string)
+	return map[string]string{"brand": CardBrand(str)}
+}
+
+// the BBAN formats of the IBAN countries. n: digits, a: upper letters, c: alphanumeric
+var ibanFormats = map[string]string{
+	"AD": "4n4n12c", "AE": "3n16n", "AL": "8n16c", "AT": "5n11n", "AZ": "4a20c",
+	"BA": "3n3n8n2n", "BE": "3n7n2n", "BG": "4a4n2n8c", "BH": "4a14c", "BR": "8n5n10n1a1c",
+	"BY": "4c4n16c", "CH": "5n12c", "CR": "4n14n", "CY": "3n5n16c", "CZ": "4n6n10n",
+	"DE": "8n10n", "DK": "4n9n1n", "DO": "4c20n", "EE": "2n2n11n1n", "EG": "4n4n17n",
+	"ES": "4n4n1n1n10n", "FI": "3n11n", "FO": "4n9n1n", "FR": "5n5n11c2n", "GB": "4a6n8n",
+	"GE": "2a16n", "GI": "4a15c", "GL": "4n9n1n", "GR": "3n4n16c", "GT": "4c20c",
+	"HR": "7n10n", "HU": "3n4n1n15n1n", "IE": "4a6n8n", "IL": "3n3n13n", "IQ": "4a3n12n",
+	"IS": "4n2n6n10n", "IT": "1a5n5n12c", "JO": "4a4n18c", "KW": "4a22c", "KZ": "3n13c",
+	"LB": "4n20c", "LC": "4a24c", "LI": "5n12c", "LT": "5n11n", "LU": "3n13c",
+	"LV": "4a13c", "MC": "5n5n11c2n", "MD": "2c18c", "ME": "3n13n2n", "MK": "3n10c2n",
+	"MR": "5n5n11n2n", "MT": "4a5n18c", "MU": "4a2n2n12n3n3a", "NL": "4a10n", "NO": "4n6n1n",
+	"PK": "4a16c", "PL": "8n16n", "PS": "4a21c", "PT": "4n4n11n2n", "QA": "4a21c",
+	"RO": "4a16c", "RS": "3n13n2n", "SA": "2n18c", "SC": "4a2n2n16n3a", "SE": "3n16n1n",
+	"SI": "5n8n2n", "SK": "4n6n10n", "SM": "1a5n5n12c", "ST": "4n4n11n2n", "SV": "4a20n",
+	"TL": "3n14n2n", "TN": "2n3n13n2n", "TR": "5n1n16c", "UA": "6n19c", "VA": "3n15n",
+	"VG": "4a16n", "XK": "4n10n2n",
+}
+
+var (
+	ibanOnce sync.Once
+	ibanRegs map[string]*regexp.Regexp
+	// the segment of the BBAN format. eg: "4n"
+	rxIBANSegment = regexp.MustCompile(`(\d+)([nac])`)
+)
+
+// get the BBAN regexp of the country
+func ibanRegexp(country string) *regexp.Regexp {
+	ibanOnce.Do(func() {
+		ibanRegs = make(map[string]*regexp.Regexp, len(ibanFormats))
+		for code, format := range ibanFormats {
+			pattern := rxIBANSegment.ReplaceAllStringFunc(format, func(seg string) string {
+				n, typ := seg[:len(seg)-1], seg[len(seg)-1]
+				switch typ {
+				case 'n':
+					return `\d{` + n + `}`
+				case 'a':
+					return `[A-Z]{` + n + `}`
+				}
+				return `[A-Z0-9]{` + n + `}`
+			})
+			ibanRegs[code] = regexp.MustCompile(`^` + pattern + `$`)
+		}
+	})
+
+	return ibanRegs[country]
+}
+
+// check the IBAN by the country BBAN format and the mod-97 checksum. the iban is normalized.
+func checkIBAN(iban string) bool {
+	if len(iban) < 5 || !isUpperAlpha(iban[:2]) || !isDigits(iban[2:4]) {
+		return false
+	}
+
+	rx := ibanRegexp(iban[:2])
+	if rx == nil || !rx.MatchString(iban[4:]) {
+		return false
+	}
+
+	// move the first 4 chars to the end
+	return mod97(iban[4:]+iban[:4]) == 1
+}
+
+// compute the ISO 7064 mod 97 of the alphanumeric string, the letters are converted to numbers. A=10 ... Z=35
+func mod97(s string) int {
+	var mod int
+	for _, c := range s {
+		if c >= 'A' && c <= 'Z' {
+			mod = (mod*100 + int(c-'A') + 10) % 97
+		} else {
+			mod = (mod*10 + int(c-'0')) % 97
+		}
+	}
+	return mod
+}
+
+func isUpperAlpha(s string) bool {
+	for _, c := range s {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// BIC format: 4 letters bank code, 2 letters country code, 2 alphanumeric location code,
+// and optional 3 alphanumeric branch code.
+var rxBIC = regexp.MustCompile(`^[A-Z]{4}([A-Z]{2})[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// check the BIC code, the country code segment should be an ISO 3166-1 alpha-2 code.
+func checkBIC(bic string) bool {
+	ss := rxBIC.FindStringSubmatch(bic)
+	if len(ss) == 0 {
+		return false
+	}
+
+	// the branch code start with "X" is only allowed "XXX", it is the primary office.
+	if branch := ss[2]; branch != "" && branch[0] == 'X' && branch != "XXX" {
+		return false
+	}
+
+	// "XK" is the code for Kosovo, used by the SWIFT.
+	return ss[1] == "XK" || isCountryCode(ss[1], "alpha2")
+}
+
+// VATChecker check the VAT number of a country, the number is without the country prefix.
+type VATChecker func(number string) bool
+
+// the EU member state prefixes of the VAT number. the Greece is "EL"
+var euVATCountries = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "EL", "ES", "FI", "FR", "HR", "HU",
+	"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
+// VAT number checkers, key is the VAT number prefix. see RegisterVATChecker()
+var vatCheckers = map[string]VATChecker{
+	"AT": vatFormat(`U\d{8}`, nil),
+	"BE": vatFormat(`[01]\d{9}`, checkBEVat),
+	"BG": vatFormat(`\d{9,10}`, nil),
+	"CY": vatFormat(`\d{8}[A-Z]`, nil),
+	"CZ": vatFormat(`\d{8,10}`, nil),
+	"DE": vatFormat(`\d{9}`, checkDEVat),
+	"DK": vatFormat(`\d{8}`, nil),
+	"EE": vatFormat(`\d{9}`, nil),
+	"EL": vatFormat(`\d{9}`, nil),
+	"ES": vatFormat(`[A-Z0-9]\d{7}[A-Z0-9]`, nil),
+	"FI": vatFormat(`\d{8}`, nil),
+	"FR": vatFormat(`[A-HJ-NP-Z0-9]{2}\d{9}`, checkFRVat),
+	"HR": vatFormat(`\d{11}`, nil),
+	"HU": vatFormat(`\d{8}`, nil),
+	"IE": vatFormat(`\d{7}[A-W][A-I]?|\d[A-Z+*]\d{5}[A-W]`, nil),
+	"IT": vatFormat(`\d{11}`, luhnCheck),
+	"LT": vatFormat(`\d{9}|\d{12}`, nil),
+	"LU": vatFormat(`\d{8}`, nil),
+	"LV": vatFormat(`\d{11}`, nil),
+	"MT": vatFormat(`\d{8}`, nil),
+	"NL": vatFormat(`\d{9}B\d{2}`, checkNLVat),
+	"PL": vatFormat(`\d{10}`, checkPLVat),
+	"PT": vatFormat(`\d{9}`, nil),
+	"RO": vatFormat(`[1-9]\d{1,9}`, nil),
+	"SE": vatFormat(`\d{10}01`, nil),
+	"SI": vatFormat(`[1-9]\d{7}`, nil),
+	"SK": vatFormat(`\d{10}`, nil),
+	// non EU
+	"GB": vatFormat(`\d{9}|\d{12}|GD[0-4]\d{2}|HA[5-9]\d{2}`, nil),
+}
+
+// RegisterVATChecker register or override the VAT number checker of a country.
+//
+// Usage:
+// 	validate.RegisterVATChecker("NO", func(number string) bool {
+// 		return len(number) == 12 && strings.HasSuffix(number, "MVA")
+// 	})
+func RegisterVATChecker(country string, checker VATChecker) {
+	if country == "" || checker == nil {
+		configErrorf("VAT country and the checker func cannot be empty")
+		return
+	}
+	vatCheckers[strings.ToUpper(country)] = checker
+}
+
+// create VAT checker by the format pattern and the checksum func.
+func vatFormat(pattern string, checksum func(number string) bool) VATChecker {
+	rx := regexp.MustCompile(`^(?:` + pattern + `)$`)
+	return func(number string) bool {
+		return rx.MatchString(number) && (checksum == nil || checksum(number))
+	}
+}
+
+// the check digits are 97 - (first 8 digits mod 97)
+func checkBEVat(number string) bool {
+	n, _ := strconv.Atoi(number[:8])
+	check, _ := strconv.Atoi(number[8:])
+	return 97-n%97 == check
+}
+
+// ISO 7064 MOD 11,10
+func checkDEVat(number string) bool {
+	product := 10
+	for _, c := range number[:8] {
+		sum := (int(c-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (2 * sum) % 11
+	}
+
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	return check == int(number[8]-'0')
+}
+
+// the numeric key is (12 + 3 * (SIREN mod 97)) mod 97. the alphabetic key is not checked.
+func checkFRVat(number string) bool {
+	key, err := strconv.Atoi(number[:2])
+	if err != nil {
+		return true
+	}
+
+	siren, _ := strconv.Atoi(number[2:])
+	return (12+3*(siren%97))%97 == key
+}
+
+// the old format use the mod 11 check, the sole proprietor format use the mod 97 check of "NL" + number
+func checkNLVat(number string) bool {
+	var sum int
+	for i, c := range number[:8] {
+		sum += int(c-'0') * (9 - i)
+	}
+	if (sum-int(number[8]-'0'))%11 == 0 {
+		return true
+	}
+	return mod97("NL"+number) == 1
+}
+
+// weighted sum mod 11 should be the last digit.
+func checkPLVat(number string) bool {
+	weights := []int{6, 5, 7, 2, 3, 4, 5, 6, 7}
+
+	var sum int
+	for i, w := range weights {
+		sum += int(number[i]-'0') * w
+	}
+	return sum%11 == int(number[9]-'0')
+}
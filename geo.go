@@ -0,0 +1,204 @@
+package validate
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// the GeoJSON object types. see RFC 7946
+var geoJSONTypes = []string{
+	"Point", "MultiPoint", "LineString", "MultiLineString", "Polygon", "MultiPolygon",
+	"GeometryCollection", "Feature", "FeatureCollection",
+}
+
+// check the latitude and longitude number are in range.
+func isLatLngInRange(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// parse the lat,lng pair value. support string "lat,lng" and the slice/array of two numbers.
+func parseLatLng(val interface{}) (lat, lng float64, ok bool) {
+	if s, isStr := val.(string); isStr {
+		nodes := strings.Split(s, ",")
+		if len(nodes) != 2 {
+			return 0, 0, false
+		}
+
+		var err error
+		if lat, err = strconv.ParseFloat(strings.TrimSpace(nodes[0]), 64); err != nil {
+			return 0, 0, false
+		}
+		if lng, err = strconv.ParseFloat(strings.TrimSpace(nodes[1]), 64); err != nil {
+			return 0, 0, false
+		}
+		return lat, lng, true
+	}
+
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array || rv.Len() != 2 {
+		return 0, 0, false
+	}
+
+	if lat, ok = toFloat64(rv.Index(0).Interface()); !ok {
+		return 0, 0, false
+	}
+	lng, ok = toFloat64(rv.Index(1).Interface())
+	return
+}
+
+// convert the number value to float64. the string is not a number.
+func toFloat64(val interface{}) (float64, bool) {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// decode the GeoJSON value. support JSON string, []byte and map[string]interface{}
+func decodeGeoJSON(val interface{}) (map[string]interface{}, bool) {
+	var bs []byte
+	switch tv := val.(type) {
+	case map[string]interface{}:
+		return tv, true
+	case string:
+		bs = []byte(tv)
+	case []byte:
+		bs = tv
+	default:
+		return nil, false
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(bs, &obj); err != nil {
+		return nil, false
+	}
+	return obj, obj != nil
+}
+
+// check the GeoJSON object. see RFC 7946
+func checkGeoJSON(obj map[string]interface{}) bool {
+	typ, _ := obj["type"].(string)
+	switch typ {
+	case "Feature":
+		// the geometry can be null
+		if geo, ok := obj["geometry"]; ok && geo != nil {
+			geoObj, ok := geo.(map[string]interface{})
+			if !ok || !checkGeometry(geoObj) {
+				return false
+			}
+		} else if !ok {
+			return false
+		}
+
+		if props, ok := obj["properties"]; ok && props != nil {
+			_, ok = props.(map[string]interface{})
+			return ok
+		}
+		return true
+	case "FeatureCollection":
+		features, ok := obj["features"].([]interface{})
+		if !ok {
+			return false
+		}
+
+		for _, item := range features {
+			feature, ok := item.(map[string]interface{})
+			if !ok || feature["type"] != "Feature" || !checkGeoJSON(feature) {
+				return false
+			}
+		}
+		return true
+	}
+	return checkGeometry(obj)
+}
+
+// check the geometry object, include the coordinates structure and range.
+func checkGeometry(obj map[string]interface{}) bool {
+	typ, _ := obj["type"].(string)
+	if typ == "GeometryCollection" {
+		geos, ok := obj["geometries"].([]interface{})
+		if !ok {
+			return false
+		}
+
+		for _, item := range geos {
+			geo, ok := item.(map[string]interface{})
+			if !ok || !checkGeometry(geo) {
+				return false
+			}
+		}
+		return true
+	}
+
+	coords := obj["coordinates"]
+	switch typ {
+	case "Point":
+		return checkPosition(coords)
+	case "MultiPoint":
+		return checkEachCoords(coords, checkPosition)
+	case "LineString":
+		return checkLineString(coords)
+	case "MultiLineString":
+		return checkEachCoords(coords, checkLineString)
+	case "Polygon":
+		return checkPolygon(coords)
+	case "MultiPolygon":
+		return checkEachCoords(coords, checkPolygon)
+	}
+	return false
+}
+
+func checkEachCoords(coords interface{}, fn func(interface{}) bool) bool {
+	items, ok := coords.([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, item := range items {
+		if !fn(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// the position is [longitude, latitude] or [longitude, latitude, altitude]
+func checkPosition(coords interface{}) bool {
+	pos, ok := coords.([]interface{})
+	if !ok || len(pos) < 2 || len(pos) > 3 {
+		return false
+	}
+
+	nums := make([]float64, len(pos))
+	for i, item := range pos {
+		if nums[i], ok = toFloat64(item); !ok {
+			return false
+		}
+	}
+	return isLatLngInRange(nums[1], nums[0])
+}
+
+// the line string has two or more positions
+func checkLineString(coords interface{}) bool {
+	items, ok := coords.([]interface{})
+	return ok && len(items) >= 2 && checkEachCoords(items, checkPosition)
+}
+
+// the polygon is the linear rings. the ring has four or more positions, the first and last are same.
+func checkPolygon(coords interface{}) bool {
+	return checkEachCoords(coords, func(ring interface{}) bool {
+		items, ok := ring.([]interface{})
+		if !ok || len(items) < 4 || !checkEachCoords(items, checkPosition) {
+			return false
+		}
+		return reflect.DeepEqual(items[0], items[len(items)-1])
+	})
+}
@@ -0,0 +1,27 @@
+package validate
+
+import "strings"
+
+// check the git reference name. see git-check-ref-format
+func checkGitRefName(s string) bool {
+	if s == "" || s == "@" || s[0] == '/' || strings.HasSuffix(s, "/") || strings.HasSuffix(s, ".") {
+		return false
+	}
+
+	if strings.Contains(s, "..") || strings.Contains(s, "//") || strings.Contains(s, "@{") {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c == 0x7f || strings.IndexByte(" ~^:?*[\\", c) >= 0 {
+			return false
+		}
+	}
+
+	for _, component := range strings.Split(s, "/") {
+		if component[0] == '.' || strings.HasSuffix(component, ".lock") {
+			return false
+		}
+	}
+	return true
+}
@@ -6,4 +6,7 @@ require (
 	github.com/gookit/filter v1.1.3
 	github.com/gookit/goutil v0.5.8
 	github.com/stretchr/testify v1.8.0
+	golang.org/x/crypto v0.0.0-20220829220503-c86fa9a7ed90
+	golang.org/x/text v0.4.0
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,276 @@
+package validate
+
+import (
+	"strings"
+	"unicode"
+)
+
+// the length modes of the string, for the length validators. see GlobalOption.LengthMode and Rule.SetLengthMode()
+const (
+	// LengthModeRune count the runes(code points), it is the default mode.
+	LengthModeRune = "rune"
+	// LengthModeGrapheme count the user-perceived characters by the Unicode grapheme clusters.
+	// eg: "👍🏽" and "🇨🇳" are 1 character.
+	LengthModeGrapheme = "grapheme"
+)
+
+// check the length mode is valid. the empty mode is the default mode.
+func isLengthMode(mode string) bool {
+	return mode == "" || mode == LengthModeRune || mode == LengthModeGrapheme
+}
+
+// the grapheme cluster break properties. see UAX #29
+const (
+	gcbOther = iota
+	gcbCR
+	gcbLF
+	gcbControl
+	gcbExtend
+	gcbZWJ
+	gcbRegionalIndicator
+	gcbPrepend
+	gcbSpacingMark
+	gcbL
+	gcbV
+	gcbT
+	gcbLV
+	gcbLVT
+	gcbExtPict
+)
+
+// the prepended concatenation marks. eg: the arabic number sign
+var gcbPrependTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+		{Lo: 0x06DD, Hi: 0x06DD, Stride: 1},
+		{Lo: 0x070F, Hi: 0x070F, Stride: 1},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+		{Lo: 0x08E2, Hi: 0x08E2, Stride: 1},
+		{Lo: 0x0D4E, Hi: 0x0D4E, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x110BD, Hi: 0x110BD, Stride: 1},
+		{Lo: 0x110CD, Hi: 0x110CD, Stride: 1},
+		{Lo: 0x111C2, Hi: 0x111C3, Stride: 1},
+	},
+}
+
+// the Extended_Pictographic chars, the emoji and the reserved emoji ranges.
+var extPictTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00A9, Hi: 0x00A9, Stride: 1},
+		{Lo: 0x00AE, Hi: 0x00AE, Stride: 1},
+		{Lo: 0x203C, Hi: 0x203C, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21A9, Hi: 0x21AA, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+		{Lo: 0x23CF, Hi: 0x23CF, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x23F8, Hi: 0x23FA, Stride: 1},
+		{Lo: 0x24C2, Hi: 0x24C2, Stride: 1},
+		{Lo: 0x25AA, Hi: 0x25AB, Stride: 1},
+		{Lo: 0x25B6, Hi: 0x25B6, Stride: 1},
+		{Lo: 0x25C0, Hi: 0x25C0, Stride: 1},
+		{Lo: 0x25FB, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2B05, Hi: 0x2B07, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303D, Hi: 0x303D, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F000, Hi: 0x1F0FF, Stride: 1},
+		{Lo: 0x1F10D, Hi: 0x1F10F, Stride: 1},
+		{Lo: 0x1F12F, Hi: 0x1F12F, Stride: 1},
+		{Lo: 0x1F16C, Hi: 0x1F171, Stride: 1},
+		{Lo: 0x1F17E, Hi: 0x1F17F, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F1AD, Hi: 0x1F1E5, Stride: 1},
+		{Lo: 0x1F201, Hi: 0x1F20F, Stride: 1},
+		{Lo: 0x1F21A, Hi: 0x1F21A, Stride: 1},
+		{Lo: 0x1F22F, Hi: 0x1F22F, Stride: 1},
+		{Lo: 0x1F232, Hi: 0x1F23A, Stride: 1},
+		{Lo: 0x1F23C, Hi: 0x1F23F, Stride: 1},
+		{Lo: 0x1F249, Hi: 0x1F3FA, Stride: 1},
+		{Lo: 0x1F400, Hi: 0x1F53D, Stride: 1},
+		{Lo: 0x1F546, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6FF, Stride: 1},
+		{Lo: 0x1F774, Hi: 0x1F77F, Stride: 1},
+		{Lo: 0x1F7D5, Hi: 0x1F7FF, Stride: 1},
+		{Lo: 0x1F80C, Hi: 0x1F80F, Stride: 1},
+		{Lo: 0x1F848, Hi: 0x1F84F, Stride: 1},
+		{Lo: 0x1F85A, Hi: 0x1F85F, Stride: 1},
+		{Lo: 0x1F888, Hi: 0x1F88F, Stride: 1},
+		{Lo: 0x1F8AE, Hi: 0x1F8FF, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F93A, Stride: 1},
+		{Lo: 0x1F93C, Hi: 0x1F945, Stride: 1},
+		{Lo: 0x1F947, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x1FC00, Hi: 0x1FFFD, Stride: 1},
+	},
+}
+
+// get the grapheme cluster break property of the rune.
+func graphemeBreakProp(r rune) int {
+	switch {
+	case r == '\r':
+		return gcbCR
+	case r == '\n':
+		return gcbLF
+	case r == 0x200D:
+		return gcbZWJ
+	case r == 0x200C, r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+		// ZWNJ, the emoji modifiers and the tag chars
+		return gcbExtend
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gcbRegionalIndicator
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return gcbL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return gcbV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return gcbT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gcbLV
+		}
+		return gcbLVT
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gcbExtend
+	case unicode.Is(unicode.Mc, r):
+		return gcbSpacingMark
+	case unicode.Is(gcbPrependTable, r):
+		return gcbPrepend
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gcbControl
+	case unicode.Is(extPictTable, r):
+		return gcbExtPict
+	}
+	return gcbOther
+}
+
+// GraphemeCount count the user-perceived characters of the string by the extended grapheme clusters.
+// see UAX #29, the Extended_Pictographic and Prepend properties are approximated.
+//
+// Usage:
+// 	GraphemeCount("👍🏽") // 1
+// 	GraphemeCount("👨‍👩‍👧") // 1
+func GraphemeCount(s string) (count int) {
+	eachGrapheme(s, func(string) {
+		count++
+	})
+	return
+}
+
+// split the string to the extended grapheme clusters, call the fn with each cluster.
+func eachGrapheme(s string, fn func(cluster string)) {
+	var start, riCount int
+	// the previous chars are "ExtPict Extend*" and "ExtPict Extend* ZWJ"
+	var inPict, pictZWJ bool
+
+	prev := -1
+	for i, r := range s {
+		prop := graphemeBreakProp(r)
+		if prev >= 0 && isGraphemeBreak(prev, prop, pictZWJ, riCount) {
+			fn(s[start:i])
+			start = i
+		}
+
+		switch prop {
+		case gcbExtPict:
+			inPict, pictZWJ = true, false
+		case gcbExtend:
+			pictZWJ = false
+		case gcbZWJ:
+			inPict, pictZWJ = false, inPict
+		default:
+			inPict, pictZWJ = false, false
+		}
+
+		if prop == gcbRegionalIndicator {
+			riCount++
+		} else {
+			riCount = 0
+		}
+		prev = prop
+	}
+
+	if start < len(s) {
+		fn(s[start:])
+	}
+}
+
+// check there is a grapheme cluster boundary between the two chars. see UAX #29 rules GB3 - GB999
+func isGraphemeBreak(prev, cur int, pictZWJ bool, riCount int) bool {
+	switch {
+	case prev == gcbCR && cur == gcbLF:
+		return false
+	case prev == gcbControl || prev == gcbCR || prev == gcbLF:
+		return true
+	case cur == gcbControl || cur == gcbCR || cur == gcbLF:
+		return true
+	case prev == gcbL && (cur == gcbL || cur == gcbV || cur == gcbLV || cur == gcbLVT):
+		return false
+	case (prev == gcbLV || prev == gcbV) && (cur == gcbV || cur == gcbT):
+		return false
+	case (prev == gcbLVT || prev == gcbT) && cur == gcbT:
+		return false
+	case cur == gcbExtend || cur == gcbZWJ || cur == gcbSpacingMark || prev == gcbPrepend:
+		return false
+	case prev == gcbZWJ && cur == gcbExtPict && pictZWJ:
+		return false
+	case prev == gcbRegionalIndicator && cur == gcbRegionalIndicator:
+		// the regional indicators are paired. eg: the flag "🇨🇳"
+		return riCount%2 == 0
+	}
+	return true
+}
+
+// get the length mode setting in the rule list. eg: "lenMode:grapheme"
+func lengthModeOf(rules []string) (mode string) {
+	for _, rule := range rules {
+		if strings.HasPrefix(rule, "lenMode:") {
+			m := strings.Trim(rule[len("lenMode:"):], ":")
+			if !isLengthMode(m) {
+				configErrorf("invalid length mode '%s', allow: rune, grapheme", m)
+				continue
+			}
+			mode = m
+		}
+	}
+	return
+}
+
+// check the string length by the length validator and the length mode.
+// the handled is false on the validator is not a length validator or the mode is rune.
+func checkLengthByMode(name, s, mode string, args []interface{}) (ok, handled bool) {
+	if mode != LengthModeGrapheme {
+		return false, false
+	}
+
+	switch name {
+	case "length":
+		return GraphemeCount(s) == args[0].(int), true
+	case "minLength":
+		return GraphemeCount(s) >= args[0].(int), true
+	case "maxLength":
+		return GraphemeCount(s) <= args[0].(int), true
+	case "stringLength":
+		ln := GraphemeCount(s)
+		if len(args) == 1 {
+			return ln >= args[0].(int), true
+		}
+		return ln >= args[0].(int) && ln <= args[1].(int), true
+	}
+	return false, false
+}
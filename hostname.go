@@ -0,0 +1,57 @@
+package validate
+
+import "strings"
+
+// the max length of the DNS name and label. see RFC 1035
+const (
+	maxDNSNameLen  = 253
+	maxDNSLabelLen = 63
+)
+
+// check the DNS label. 1-63 letters, digits and hyphens, cannot start or end with hyphen. see RFC 1123
+func checkDNSLabel(s string) bool {
+	if s == "" || len(s) > maxDNSLabelLen || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// check the hostname, the labels are separated by dot.
+func checkHostname(s string) bool {
+	if s == "" || len(s) > maxDNSNameLen {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if !checkDNSLabel(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// check the top-level domain. the letters only, or the IDN in punycode. eg: "com", "xn--fiqs8s"
+func checkTLD(s string) bool {
+	if strings.HasPrefix(strings.ToLower(s), "xn--") {
+		return len(s) > 4 && checkDNSLabel(s)
+	}
+	return len(s) >= 2 && isAlpha(s)
+}
+
+// check the string only contains ASCII letters.
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i] | 0x20
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,310 @@
+package validate
+
+import (
+	"html"
+	"strings"
+)
+
+// the HTML elements that run script or load the active content.
+var scriptTags = map[string]bool{
+	"script": true, "iframe": true, "frame": true, "frameset": true, "object": true,
+	"embed": true, "applet": true, "base": true, "meta": true, "link": true,
+}
+
+// the default allowed tags of the safeRichText rule.
+var richTextTags = []string{
+	"a", "abbr", "b", "blockquote", "br", "code", "del", "em", "h1", "h2", "h3", "h4", "h5", "h6",
+	"hr", "i", "img", "ins", "li", "ol", "p", "pre", "s", "small", "strong", "sub", "sup", "u", "ul",
+}
+
+// the allowed attributes of the safeRichText rule.
+var richTextAttrs = map[string]bool{
+	"href": true, "src": true, "alt": true, "title": true, "width": true, "height": true,
+	"cite": true, "lang": true, "dir": true, "rel": true, "target": true, "class": true,
+}
+
+// the elements the content is the raw text, the tags in the content are not parsed.
+// the content of them is dropped on sanitizing.
+var rawTextTags = map[string]bool{
+	"script": true, "style": true, "textarea": true, "title": true, "xmp": true,
+	"iframe": true, "noembed": true, "noframes": true, "noscript": true, "plaintext": true,
+}
+
+// the HTML markup parsed by parseHTML()
+type htmlMarkup struct {
+	// the tag name in lower case. it is empty for the comment, doctype and processing instruction.
+	name  string
+	end   bool
+	attrs []htmlAttr
+	// the position of the markup in the value, [pos, next)
+	pos, next int
+}
+
+type htmlAttr struct {
+	name string
+	// the value is unescaped. eg: "&#106;avascript:" -> "javascript:"
+	value string
+}
+
+// parse the HTML markups(tags, comments) of the value like the browser tokenizer, the text is skipped.
+// the unterminated tag at the end is also returned.
+func parseHTML(s string) []htmlMarkup {
+	var markups []htmlMarkup
+	for i := 0; i < len(s); {
+		pos := strings.IndexByte(s[i:], '<')
+		if pos < 0 || i+pos+1 >= len(s) {
+			break
+		}
+
+		start := i + pos
+		i = start + 1
+		c := s[i]
+		switch {
+		case isASCIILetter(c):
+			m, next := parseHTMLTag(s, i, false)
+			m.pos, m.next = start, next
+			markups = append(markups, m)
+			i = next
+
+			// skip the raw text to the end tag, the "plaintext" has no end tag
+			if m.name == "plaintext" {
+				return markups
+			}
+			if rawTextTags[m.name] {
+				end := strings.Index(strings.ToLower(s[i:]), "</"+m.name)
+				if end < 0 {
+					return markups
+				}
+				i += end
+			}
+		case c == '/' && i+1 < len(s) && isASCIILetter(s[i+1]):
+			m, next := parseHTMLTag(s, i+1, true)
+			m.pos, m.next = start, next
+			markups = append(markups, m)
+			i = next
+		case c == '!' || c == '?' || c == '/':
+			// the comment, doctype or the bogus comment
+			end := "-->"
+			if !strings.HasPrefix(s[i:], "!--") {
+				end = ">"
+			}
+
+			if pos = strings.Index(s[i:], end); pos < 0 {
+				return append(markups, htmlMarkup{pos: start, next: len(s)})
+			}
+			i += pos + len(end)
+			markups = append(markups, htmlMarkup{pos: start, next: i})
+		}
+	}
+	return markups
+}
+
+// parse the tag from the tag name start position, returns the tag and the position after the tag.
+func parseHTMLTag(s string, i int, end bool) (m htmlMarkup, next int) {
+	m.end = end
+	start := i
+	for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '/' && s[i] != '>' {
+		i++
+	}
+	m.name = strings.ToLower(s[start:i])
+
+	for i < len(s) {
+		for i < len(s) && (isHTMLSpace(s[i]) || s[i] == '/') {
+			i++
+		}
+		if i >= len(s) {
+			break
+		}
+		if s[i] == '>' {
+			return m, i + 1
+		}
+
+		// the attribute name, the first char can be "="
+		start = i
+		for i++; i < len(s) && !isHTMLSpace(s[i]) && s[i] != '/' && s[i] != '>' && s[i] != '='; i++ {
+		}
+		attr := htmlAttr{name: strings.ToLower(s[start:i])}
+
+		for i < len(s) && isHTMLSpace(s[i]) {
+			i++
+		}
+		if i < len(s) && s[i] == '=' {
+			for i++; i < len(s) && isHTMLSpace(s[i]); i++ {
+			}
+
+			start = i
+			if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+				quote := s[i]
+				if pos := strings.IndexByte(s[i+1:], quote); pos >= 0 {
+					start, i = i+1, i+1+pos
+					attr.value = s[start:i]
+					i++
+				} else {
+					attr.value, i = s[i+1:], len(s)
+				}
+			} else {
+				for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '>' {
+					i++
+				}
+				attr.value = s[start:i]
+			}
+			attr.value = html.UnescapeString(attr.value)
+		}
+		m.attrs = append(m.attrs, attr)
+	}
+	return m, len(s)
+}
+
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// check the markup is safe: not the script element, no event handler attributes and no script URLs.
+func isSafeMarkup(m htmlMarkup) bool {
+	if scriptTags[m.name] {
+		return false
+	}
+
+	for _, attr := range m.attrs {
+		if strings.HasPrefix(attr.name, "on") || attr.name == "srcdoc" || isScriptValue(attr.name, attr.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// the URL schemes run the script.
+var scriptSchemes = []string{"javascript:", "vbscript:", "livescript:", "data:text/html"}
+
+// check the attribute value is the script URL, or the style contains the script.
+// the browsers ignore the whitespace and control chars in the URL scheme, eg: "java\tscript:"
+func isScriptValue(name, val string) bool {
+	val = strings.ToLower(strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, val))
+
+	if name == "style" {
+		return strings.Contains(val, "javascript:") || strings.Contains(val, "expression(")
+	}
+
+	for _, scheme := range scriptSchemes {
+		if strings.HasPrefix(val, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// escape the text for write to the HTML. the text is unescaped first, so the entities are not double escaped.
+var htmlTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escapeHTMLText(text string) string {
+	return htmlTextEscaper.Replace(html.UnescapeString(text))
+}
+
+// HTMLPolicy is the HTML sanitizer policy, it is compatible with the bluemonday.Policy. see RegisterHTMLPolicy()
+type HTMLPolicy interface {
+	Sanitize(s string) string
+}
+
+// allowlistPolicy is the built-in HTMLPolicy, only the allowed tags and attributes are kept.
+type allowlistPolicy struct {
+	tags, attrs map[string]bool
+}
+
+func newAllowlistPolicy(tags []string, attrs map[string]bool) *allowlistPolicy {
+	p := &allowlistPolicy{tags: make(map[string]bool, len(tags)), attrs: attrs}
+	for _, tag := range tags {
+		p.tags[tag] = true
+	}
+	return p
+}
+
+// Sanitize the HTML. the disallowed tags and comments are removed and their text is kept,
+// the content of the raw text elements(script, style, textarea ...) is removed. the disallowed
+// attributes are removed. the text is escaped, so it can not be parsed as the markup.
+func (p *allowlistPolicy) Sanitize(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	var last int
+	var dropText bool
+	for _, m := range parseHTML(s) {
+		if !dropText {
+			sb.WriteString(escapeHTMLText(s[last:m.pos]))
+		}
+		last, dropText = m.next, !m.end && rawTextTags[m.name]
+
+		// the unterminated tag at the end is dropped like the browser
+		if m.name == "" || !p.tags[m.name] || scriptTags[m.name] || s[m.next-1] != '>' {
+			continue
+		}
+
+		if m.end {
+			sb.WriteString("</" + m.name + ">")
+			continue
+		}
+
+		sb.WriteString("<" + m.name)
+		for _, attr := range m.attrs {
+			if !p.attrs[attr.name] || strings.HasPrefix(attr.name, "on") || isScriptValue(attr.name, attr.value) {
+				continue
+			}
+			sb.WriteString(" " + attr.name + `="` + html.EscapeString(attr.value) + `"`)
+		}
+		sb.WriteByte('>')
+	}
+
+	if !dropText {
+		sb.WriteString(escapeHTMLText(s[last:]))
+	}
+	return sb.String()
+}
+
+// the registered HTML policies, key is the policy name. see RegisterHTMLPolicy()
+var htmlPolicies = map[string]HTMLPolicy{
+	// remove all the tags, only the text is kept
+	"strict": newAllowlistPolicy(nil, nil),
+	// the user generated content, the allowed tags are same as the safeRichText rule
+	"ugc": newAllowlistPolicy(richTextTags, richTextAttrs),
+}
+
+// RegisterHTMLPolicy register or override the HTML sanitizer policy by name, it is used by the "sanitizeHTML" filter.
+// the built-in policies are "strict" and "ugc"
+//
+// Usage:
+// 	validate.RegisterHTMLPolicy("comment", bluemonday.UGCPolicy())
+func RegisterHTMLPolicy(name string, p HTMLPolicy) {
+	if name == "" || p == nil {
+		configErrorf("the HTML policy name and the policy cannot be empty")
+		return
+	}
+	htmlPolicies[name] = p
+}
+
+// SanitizeHTML filter, clean the HTML by the registered policy. the default policy is "ugc".
+// the value is escaped on the policy is not registered(NoPanic mode).
+//
+// Usage:
+// 	v.FilterRule("comment", "sanitizeHTML")
+// 	v.FilterRule("title", "sanitizeHTML:strict")
+func SanitizeHTML(s string, policy ...string) string {
+	name := "ugc"
+	if len(policy) > 0 && policy[0] != "" {
+		name = policy[0]
+	}
+
+	p, ok := htmlPolicies[name]
+	if !ok {
+		configErrorf("the HTML policy '%s' is not registered, see RegisterHTMLPolicy()", name)
+		return html.EscapeString(s)
+	}
+	return p.Sanitize(s)
+}
@@ -0,0 +1,163 @@
+package validate
+
+import (
+	"strings"
+)
+
+// NationalIDChecker check the national ID number of a country.
+type NationalIDChecker func(id string) bool
+
+// national ID checkers, key is the ISO 3166-1 alpha-2 country code. see RegisterNationalIDChecker()
+var nationalIDCheckers = map[string]NationalIDChecker{
+	// CPF for persons, or CNPJ for companies
+	"BR": func(id string) bool {
+		id = strings.NewReplacer(".", "", "-", "", "/", "").Replace(id)
+		return checkCPF(id) || checkCNPJ(id)
+	},
+	// DNI for citizens, or NIE for foreigners
+	"ES": checkDNI,
+	// National Insurance number
+	"GB": checkNINO,
+	// Aadhaar number
+	"IN": checkAadhaar,
+}
+
+// RegisterNationalIDChecker register or override the national ID checker of a country.
+//
+// Usage:
+// 	validate.RegisterNationalIDChecker("SE", func(id string) bool {
+// 		// check the personnummer ...
+// 	})
+func RegisterNationalIDChecker(country string, checker NationalIDChecker) {
+	if country == "" || checker == nil {
+		configErrorf("national ID country and the checker func cannot be empty")
+		return
+	}
+	nationalIDCheckers[strings.ToUpper(country)] = checker
+}
+
+// check the digits are all same. eg: "00000000000"
+func isRepeatDigits(s string) bool {
+	return strings.Count(s, s[:1]) == len(s)
+}
+
+// compute the check digit of the Brazil CPF/CNPJ
+func brCheckDigit(digits string, weights []int) byte {
+	var sum int
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+
+	if mod := sum % 11; mod >= 2 {
+		return byte('0' + 11 - mod)
+	}
+	return '0'
+}
+
+// Brazil CPF, 11 digits with 2 check digits.
+func checkCPF(id string) bool {
+	if len(id) != 11 || !isDigits(id) || isRepeatDigits(id) {
+		return false
+	}
+
+	w1 := []int{10, 9, 8, 7, 6, 5, 4, 3, 2}
+	w2 := []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}
+	return brCheckDigit(id, w1) == id[9] && brCheckDigit(id, w2) == id[10]
+}
+
+// Brazil CNPJ, 14 digits with 2 check digits.
+func checkCNPJ(id string) bool {
+	if len(id) != 14 || !isDigits(id) || isRepeatDigits(id) {
+		return false
+	}
+
+	w1 := []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	w2 := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	return brCheckDigit(id, w1) == id[12] && brCheckDigit(id, w2) == id[13]
+}
+
+// Spain DNI: 8 digits and a check letter. NIE: X/Y/Z, 7 digits and a check letter.
+func checkDNI(id string) bool {
+	id = strings.ToUpper(strings.Replace(id, "-", "", -1))
+	if len(id) != 9 {
+		return false
+	}
+
+	// NIE: X=0, Y=1, Z=2
+	if pos := strings.IndexByte("XYZ", id[0]); pos >= 0 {
+		id = string('0'+byte(pos)) + id[1:]
+	}
+
+	if !isDigits(id[:8]) {
+		return false
+	}
+
+	var n int
+	for _, c := range id[:8] {
+		n = n*10 + int(c-'0')
+	}
+	return "TRWAGMYFPDXBNJZSQVHLCKE"[n%23] == id[8]
+}
+
+// the invalid prefixes of the UK NINO
+var invalidNINOPrefixes = []string{"BG", "GB", "NK", "KN", "TN", "NT", "ZZ"}
+
+// UK NINO: 2 prefix letters, 6 digits and a suffix letter(A-D). eg: "AB 12 34 56 C"
+func checkNINO(id string) bool {
+	id = strings.ToUpper(strings.Replace(id, " ", "", -1))
+	if len(id) != 9 || !isDigits(id[2:8]) || id[8] < 'A' || id[8] > 'D' {
+		return false
+	}
+
+	// the first letter cannot be D, F, I, Q, U, V. the second letter cannot be D, F, I, O, Q, U, V
+	if !isUpperAlpha(id[:2]) || strings.ContainsAny(id[:1], "DFIQUV") || strings.ContainsAny(id[1:2], "DFIOQUV") {
+		return false
+	}
+
+	for _, prefix := range invalidNINOPrefixes {
+		if id[:2] == prefix {
+			return false
+		}
+	}
+	return true
+}
+
+// the Verhoeff algorithm tables
+var (
+	verhoeffD = [10][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	verhoeffP = [8][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 7, 6, 8, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+)
+
+// India Aadhaar: 12 digits, cannot start with 0 or 1, with the Verhoeff check digit.
+func checkAadhaar(id string) bool {
+	id = strings.Replace(id, " ", "", -1)
+	if len(id) != 12 || !isDigits(id) || id[0] < '2' {
+		return false
+	}
+
+	var c int
+	for i := 0; i < len(id); i++ {
+		c = verhoeffD[c][verhoeffP[i%8][id[len(id)-1-i]-'0']]
+	}
+	return c == 0
+}
@@ -0,0 +1,196 @@
+package validate
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// the ACE prefix of the IDN label. see RFC 5890
+const idnPrefix = "xn--"
+
+// ToPunycode filter, convert the internationalized domain to punycode(ASCII). eg: "münchen.de" -> "xn--mnchen-3ya.de"
+// support the domain, email address and URL. will return the raw value on convert failed.
+//
+// Usage:
+// 	v.FilterRule("website", "toPunycode")
+func ToPunycode(s string) string {
+	switch {
+	case strings.Contains(s, "://"):
+		u, err := url.Parse(s)
+		if err != nil || u.Host == "" {
+			return s
+		}
+
+		host, ok := domainToASCII(u.Hostname())
+		if !ok {
+			return s
+		}
+
+		if port := u.Port(); port != "" {
+			host += ":" + port
+		}
+		u.Host = host
+		return u.String()
+	case strings.ContainsRune(s, '@'):
+		pos := strings.LastIndexByte(s, '@')
+		if domain, ok := domainToASCII(s[pos+1:]); ok {
+			return s[:pos+1] + domain
+		}
+		return s
+	}
+
+	if domain, ok := domainToASCII(s); ok {
+		return domain
+	}
+	return s
+}
+
+// get the hostname by the rule mode. the mode "idn" will convert the IDN to punycode.
+func idnHostname(s string, mode []string) (string, bool) {
+	if len(mode) == 0 || mode[0] == "" {
+		return s, true
+	}
+
+	if mode[0] != "idn" {
+		configErrorf("invalid hostname mode '%s', allow: idn", mode[0])
+		return s, false
+	}
+	return domainToASCII(s)
+}
+
+// the dots of the IDN. see RFC 3490 section 3.1
+var idnDotReplacer = strings.NewReplacer("。", ".", "．", ".", "｡", ".")
+
+// convert the domain to ASCII, the non-ASCII label will be NFC normalized, lower cased and encoded by punycode.
+func domainToASCII(domain string) (string, bool) {
+	if domain == "" {
+		return "", false
+	}
+
+	labels := strings.Split(idnDotReplacer.Replace(domain), ".")
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+
+		encoded, ok := punycodeEncode(strings.ToLower(norm.NFC.String(label)))
+		if !ok {
+			return "", false
+		}
+		labels[i] = idnPrefix + encoded
+	}
+	return strings.Join(labels, "."), true
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// the punycode parameters. see RFC 3492
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+	punyMaxDelta    = 1<<31 - 1
+)
+
+// encode the unicode string by the punycode. see RFC 3492
+func punycodeEncode(s string) (string, bool) {
+	runes := []rune(s)
+	out := make([]byte, 0, len(s)*2)
+	for _, r := range runes {
+		if r < 0x80 {
+			out = append(out, byte(r))
+		}
+	}
+
+	basic := len(out)
+	handled := basic
+	if basic > 0 {
+		out = append(out, '-')
+	}
+
+	n, delta, bias := rune(punyInitialN), 0, punyInitialBias
+	for handled < len(runes) {
+		// the min code point >= n
+		m := rune(0x10FFFF + 1)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+
+		if int(m-n) > (punyMaxDelta-delta)/(handled+1) {
+			return "", false
+		}
+		delta += int(m-n) * (handled + 1)
+		n = m
+
+		for _, r := range runes {
+			if r < n {
+				delta++
+			}
+			if r != n {
+				continue
+			}
+
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := k - bias
+				if t < punyTMin {
+					t = punyTMin
+				} else if t > punyTMax {
+					t = punyTMax
+				}
+
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+
+			out = append(out, punyDigit(q))
+			bias = punyAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+		delta++
+		n++
+	}
+	return string(out), true
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punyAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+
+	delta += delta / numPoints
+	k := 0
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
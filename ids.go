@@ -0,0 +1,87 @@
+package validate
+
+import (
+	"strings"
+	"time"
+)
+
+// check the UUID version and the RFC 4122 variant. the version can be "v1" - "v8", "nil" and "max". see RFC 9562
+func checkUUIDVersion(s, version string) bool {
+	switch version = strings.ToLower(version); version {
+	case "nil":
+		return s == "00000000-0000-0000-0000-000000000000"
+	case "max":
+		return s == "ffffffff-ffff-ffff-ffff-ffffffffffff"
+	}
+
+	version = strings.TrimPrefix(version, "v")
+	if len(version) != 1 || version[0] < '1' || version[0] > '8' {
+		configErrorf("invalid UUID version '%s', allow: v1 - v8, nil, max", version)
+		return false
+	}
+	return s[14] == version[0] && strings.IndexByte("89ab", s[19]) >= 0
+}
+
+// the Crockford's base32 alphabet of the ULID
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// check the ULID. 26 chars of Crockford's base32, the max value is "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"
+func checkULID(s string) bool {
+	if len(s) != 26 || s[0] > '7' {
+		return false
+	}
+
+	// the ULID is case insensitive
+	for _, c := range []byte(strings.ToUpper(s)) {
+		if strings.IndexByte(crockfordBase32, c) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// the max KSUID in base62. the base62 alphabet is in ASCII order, can compare as string.
+const maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// check the KSUID. 27 chars of base62, it cannot exceed the max 160 bits value.
+func checkKSUID(s string) bool {
+	if len(s) != len(maxKSUID) || s > maxKSUID {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isAlnum(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// check the xid. 20 chars of base32hex in lower case.
+// the 12 bytes only use 1 bit of the last char, so it must be '0' or 'g'
+func checkXID(s string) bool {
+	if len(s) != 20 || s[19] != '0' && s[19] != 'g' {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; !(c >= '0' && c <= '9' || c >= 'a' && c <= 'v') {
+			return false
+		}
+	}
+	return true
+}
+
+// the default epoch of the snowflake ID in milliseconds, it is the twitter epoch: 2010-11-04T01:42:54.657Z
+const defaultSnowflakeEpoch int64 = 1288834974657
+
+// check the snowflake ID. the high 41 bits after the sign bit is the milliseconds since the epoch,
+// the timestamp cannot be in the future.
+func checkSnowflake(id, epoch int64) bool {
+	if id <= 0 {
+		return false
+	}
+
+	ts := id>>22 + epoch
+	return ts <= NowFunc().UnixNano()/int64(time.Millisecond)
+}
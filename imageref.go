@@ -0,0 +1,67 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+)
+
+// the image reference grammar of the distribution spec.
+// see https://github.com/distribution/distribution/blob/main/reference/reference.go
+const (
+	imageDomainComponent = `(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])`
+	imageDomain          = `(?:` + imageDomainComponent + `(?:\.` + imageDomainComponent + `)*|\[[a-fA-F0-9:]+\])(?::[0-9]+)?`
+	imagePathComponent   = `[a-z0-9]+(?:(?:[._]|__|[-]+)[a-z0-9]+)*`
+	imageTag             = `[\w][\w.-]{0,127}`
+	imageDigest          = `[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}`
+)
+
+var (
+	rxImageDomain = regexp.MustCompile(`^` + imageDomain + `$`)
+	rxImagePath   = regexp.MustCompile(`^` + imagePathComponent + `(?:/` + imagePathComponent + `)*$`)
+	rxImageTag    = regexp.MustCompile(`^` + imageTag + `$`)
+	rxImageDigest = regexp.MustCompile(`^` + imageDigest + `$`)
+)
+
+// the max length of the image name, include the domain.
+const maxImageNameLen = 255
+
+// the parts of the image reference: [domain/]path[:tag][@digest]
+type imageRef struct {
+	domain, path, tag, digest string
+}
+
+// parse the image reference. eg: "ghcr.io/org/app:1.2.3@sha256:..."
+func parseImageRef(s string) (ref imageRef, ok bool) {
+	name := s
+	if pos := strings.IndexByte(name, '@'); pos >= 0 {
+		name, ref.digest = name[:pos], name[pos+1:]
+		if !rxImageDigest.MatchString(ref.digest) {
+			return ref, false
+		}
+	}
+
+	// the tag is after the last '/', the ':' before it is the port
+	if pos := strings.LastIndexByte(name, ':'); pos > strings.LastIndexByte(name, '/') {
+		name, ref.tag = name[:pos], name[pos+1:]
+		if !rxImageTag.MatchString(ref.tag) {
+			return ref, false
+		}
+	}
+
+	if name == "" || len(name) > maxImageNameLen {
+		return ref, false
+	}
+
+	// the first component is the domain when it contains '.' or ':', or it is "localhost"
+	ref.path = name
+	if pos := strings.IndexByte(name, '/'); pos >= 0 {
+		first := name[:pos]
+		if strings.ContainsAny(first, ".:") || first == "localhost" || first != strings.ToLower(first) {
+			ref.domain, ref.path = first, name[pos+1:]
+			if !rxImageDomain.MatchString(ref.domain) {
+				return ref, false
+			}
+		}
+	}
+	return ref, rxImagePath.MatchString(ref.path)
+}
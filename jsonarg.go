@@ -0,0 +1,120 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// the prefix of the JSON literal arg. eg: "json([1,2,3])"
+const jsonArgPrefix = "json("
+
+var (
+	jsonArgMu sync.RWMutex
+	// the parsed JSON args. key is the JSON text
+	jsonArgCache = make(map[string]interface{})
+)
+
+// find the end index of the JSON literal arg, it is the ")" out of the JSON strings.
+// the start is the index of the JSON text, returns -1 on not found.
+func jsonArgEnd(s string, start int) int {
+	var inStr bool
+	for i := start; i < len(s); i++ {
+		switch c := s[i]; {
+		case inStr && c == '\\':
+			i++
+		case c == '"':
+			inStr = !inStr
+		case c == ')' && !inStr:
+			return i
+		}
+	}
+	return -1
+}
+
+// parse the JSON literal arg, the parsed value is cached. don't modify the returned slice or map.
+//   - the number is int64 or float64
+//   - the array of strings, int64 or float64 is converted to []string, []int64, []float64
+//   - the object is map[string]interface{}
+func parseJSONArg(text string) interface{} {
+	text = strings.TrimSpace(text)
+
+	jsonArgMu.RLock()
+	val, ok := jsonArgCache[text]
+	jsonArgMu.RUnlock()
+	if ok {
+		return val
+	}
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(text)))
+	dec.UseNumber()
+	if err := dec.Decode(&val); err != nil {
+		configErrorf("invalid JSON argument '%s': %s", text, err.Error())
+		return text
+	}
+
+	val = normalizeJSONArg(val)
+	jsonArgMu.Lock()
+	jsonArgCache[text] = val
+	jsonArgMu.Unlock()
+	return val
+}
+
+func normalizeJSONArg(val interface{}) interface{} {
+	switch tv := val.(type) {
+	case json.Number:
+		if i64, err := tv.Int64(); err == nil {
+			return i64
+		}
+		f64, _ := tv.Float64()
+		return f64
+	case map[string]interface{}:
+		for key, item := range tv {
+			tv[key] = normalizeJSONArg(item)
+		}
+		return tv
+	case []interface{}:
+		var strNum, intNum, floatNum int
+		for i, item := range tv {
+			tv[i] = normalizeJSONArg(item)
+			switch tv[i].(type) {
+			case string:
+				strNum++
+			case int64:
+				intNum++
+			case float64:
+				floatNum++
+			}
+		}
+
+		switch ln := len(tv); {
+		case ln == 0:
+			return tv
+		case strNum == ln:
+			ss := make([]string, ln)
+			for i, item := range tv {
+				ss[i] = item.(string)
+			}
+			return ss
+		case intNum == ln:
+			ints := make([]int64, ln)
+			for i, item := range tv {
+				ints[i] = item.(int64)
+			}
+			return ints
+		case intNum+floatNum == ln:
+			floats := make([]float64, ln)
+			for i, item := range tv {
+				if i64, ok := item.(int64); ok {
+					floats[i] = float64(i64)
+				} else {
+					floats[i] = item.(float64)
+				}
+			}
+			return floats
+		}
+		return tv
+	}
+	return val
+}
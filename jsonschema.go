@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"strings"
+	"sync"
+)
+
+// JSONSchemaValidator validate the decoded JSON value by a JSON Schema. see RegisterJSONSchema()
+//
+// The value is decoded by json.Unmarshal to interface{}. It can be implemented by a full JSON Schema library.
+type JSONSchemaValidator interface {
+	ValidateJSON(v interface{}) error
+}
+
+// JSONSchemaFunc adapt the func to the JSONSchemaValidator. eg: the Validate method of a JSON Schema library.
+type JSONSchemaFunc func(v interface{}) error
+
+// ValidateJSON the decoded JSON value.
+func (fn JSONSchemaFunc) ValidateJSON(v interface{}) error {
+	return fn(v)
+}
+
+var (
+	jsonSchemaMu sync.RWMutex
+	// the registered JSON schemas, key is the schema name. see RegisterJSONSchema()
+	jsonSchemas = make(map[string]JSONSchemaValidator)
+)
+
+// RegisterJSONSchema register or override the JSON schema by name, it is used by the "json:schema(name)" rule.
+//
+// Usage:
+// 	// eg: github.com/santhosh-tekuri/jsonschema
+// 	schema := jsonschema.MustCompileString("webhook.json", webhookSchema)
+// 	validate.RegisterJSONSchema("webhookPayload", validate.JSONSchemaFunc(schema.Validate))
+func RegisterJSONSchema(name string, schema JSONSchemaValidator) {
+	name = strings.TrimSpace(name)
+	if name == "" || schema == nil {
+		configErrorf("the JSON schema name and the schema cannot be empty")
+		return
+	}
+
+	jsonSchemaMu.Lock()
+	jsonSchemas[name] = schema
+	jsonSchemaMu.Unlock()
+}
+
+// get the registered JSON schema by name.
+func jsonSchemaOf(name string) (schema JSONSchemaValidator, ok bool) {
+	jsonSchemaMu.RLock()
+	schema, ok = jsonSchemas[name]
+	jsonSchemaMu.RUnlock()
+	return
+}
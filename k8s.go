@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+)
+
+// the kubernetes naming rules. same as the k8s.io/apimachinery/pkg/util/validation
+const (
+	k8sLabelMaxLen         = 63
+	k8sSubdomainMaxLen     = 253
+	k8sQualifiedNameMaxLen = 63
+)
+
+var (
+	rxK8sDNS1123Label     = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	rxK8sDNS1123Subdomain = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	rxK8sQualifiedName    = regexp.MustCompile(`^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`)
+)
+
+// check the DNS-1123 label of the kubernetes. eg: the namespace, service name
+func isK8sDNS1123Label(s string) bool {
+	return len(s) <= k8sLabelMaxLen && rxK8sDNS1123Label.MatchString(s)
+}
+
+// check the DNS-1123 subdomain of the kubernetes. most resource names use it.
+func isK8sDNS1123Subdomain(s string) bool {
+	return len(s) <= k8sSubdomainMaxLen && rxK8sDNS1123Subdomain.MatchString(s)
+}
+
+// check the label value, it can be empty. the non-empty value is same as the qualified name.
+func isK8sLabelValue(s string) bool {
+	return s == "" || len(s) <= k8sLabelMaxLen && rxK8sQualifiedName.MatchString(s)
+}
+
+// check the qualified name: "[prefix/]name", the prefix is a DNS-1123 subdomain.
+// it is used by the label key and the annotation key. eg: "app.kubernetes.io/name"
+func isK8sQualifiedName(s string) bool {
+	name := s
+	if pos := strings.IndexByte(s, '/'); pos >= 0 {
+		if !isK8sDNS1123Subdomain(s[:pos]) {
+			return false
+		}
+		name = s[pos+1:]
+	}
+	return len(name) <= k8sQualifiedNameMaxLen && rxK8sQualifiedName.MatchString(name)
+}
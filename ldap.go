@@ -0,0 +1,113 @@
+package validate
+
+import "strings"
+
+// check the LDAP distinguished name. see RFC 4514
+// the spaces around the separators ",", "+" and "=" are allowed, like the most LDAP libraries.
+// eg: "cn=John Smith,ou=People,dc=example,dc=com", "uid=a\,b+cn=c,dc=example"
+func checkLDAPDN(s string) bool {
+	if strings.TrimSpace(s) == "" {
+		return false
+	}
+
+	for i := 0; i <= len(s); {
+		// the attribute type
+		i = skipSpaces(s, i)
+		start := i
+		for i < len(s) && s[i] != '=' && s[i] != ' ' {
+			i++
+		}
+		if !isLDAPAttrType(s[start:i]) {
+			return false
+		}
+
+		if i = skipSpaces(s, i); i >= len(s) || s[i] != '=' {
+			return false
+		}
+
+		// the attribute value
+		var ok bool
+		if i, ok = scanLDAPValue(s, skipSpaces(s, i+1)); !ok {
+			return false
+		}
+
+		if i = skipSpaces(s, i); i == len(s) {
+			return true
+		}
+		if s[i] != ',' && s[i] != '+' {
+			return false
+		}
+		i++
+	}
+	return false
+}
+
+func skipSpaces(s string, i int) int {
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	return i
+}
+
+// check the attribute type: the descr(eg "cn", "x-attr") or the numeric OID(eg "2.5.4.3")
+func isLDAPAttrType(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	if s[0] >= '0' && s[0] <= '9' {
+		for _, n := range strings.Split(s, ".") {
+			if n == "" || !isDigits(n) || len(n) > 1 && n[0] == '0' {
+				return false
+			}
+		}
+		return true
+	}
+
+	if !isASCIILetter(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if c := s[i]; !isASCIILetter(c) && !(c >= '0' && c <= '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// scan the attribute value from the position, returns the end position.
+// the value is the "#" hex string, or the string with the escaped special chars.
+func scanLDAPValue(s string, i int) (int, bool) {
+	if i < len(s) && s[i] == '#' {
+		start := i + 1
+		for i = start; i < len(s) && isHexChar(s[i]); i++ {
+		}
+		return i, i > start && (i-start)%2 == 0
+	}
+
+	// the trailing spaces before the separator are allowed
+	for ; i < len(s); i++ {
+		switch c := s[i]; c {
+		case ',', '+':
+			return i, true
+		case '\\':
+			if i+1 >= len(s) {
+				return i, false
+			}
+			if strings.IndexByte(`"+,;<>\ #=`, s[i+1]) >= 0 {
+				i++
+			} else if i+2 < len(s) && isHexChar(s[i+1]) && isHexChar(s[i+2]) {
+				i += 2
+			} else {
+				return i, false
+			}
+		case '"', ';', '<', '>', 0:
+			return i, false
+		}
+	}
+	return i, true
+}
+
+func isHexChar(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
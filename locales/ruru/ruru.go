@@ -19,7 +19,10 @@ func RegisterGlobal() {
 var Data = map[string]string{
 	"_":         "Поле {field} не прошло проверку",
 	"_validate": "Поле {field} не прошло проверку",
-	"_filter":   "Значение {field} некорректно",
+	"_filter":   "Значение {field} некорректно: {error}",
+	"_not":      "Поле {field} не должно проходить проверку {rule}",
+	"_timeout":  "Превышено время проверки поля {field}",
+	"_fieldRef": "{field} не может быть проверено, поле %s отсутствует",
 	// int
 	"min": "Минимальное значение {field} равно %v",
 	"max": "Максимальное значение {field} равно %v",
@@ -66,6 +69,11 @@ var Data = map[string]string{
 	"lteField": "{field} должно быть меньше или равно значению поля %s",
 	"gtField":  "{field} должно быть больше значения поля %s",
 	"gteField": "{field} должно быть больше или равно значению поля %s",
+	// field date compare
+	"afterField":         "{field} должно быть датой после поля %s",
+	"afterOrEqualField":  "{field} должно быть датой после или равной полю %s",
+	"beforeField":        "{field} должно быть датой до поля %s",
+	"beforeOrEqualField": "{field} должно быть датой до или равной полю %s",
 	// data type
 	"bool":    "{field} должно быть логическим",
 	"float":   "{field} должно быть плавающим числом",
@@ -74,11 +82,13 @@ var Data = map[string]string{
 	"array":   "{field} должно быть массивом",
 	"strings": "{field} должно быть массивом строк",
 	"notIn":   "{field} не должно быть в данном списке %d",
+	// registered enum sets
+	"notEnum": "{field} не должно быть в перечислении %v",
 	//
 	"contains":    "{field} должно содержать %s",
 	"notContains": "{field} не должно содержать %s",
-	"startsWith":  "{field} должно начинаться с %s",
-	"endsWith":    "{field} должно заканчиваться на %s",
+	"startsWith":  "{field} должно начинаться с {affixes}",
+	"endsWith":    "{field} должно заканчиваться на {affixes}",
 	"email":       "{field} должно быть электронной почтой",
 	"regex":       "{field} не прошло проверку регулярным выражением",
 	"file":        "{field} должно быть файлом",
@@ -89,6 +99,25 @@ var Data = map[string]string{
 	"ltDate":  "{field} должно быть датой до %s",
 	"gteDate": "{field} должно быть датой после %s включительно",
 	"lteDate": "{field} должно быть датой до %s включительно",
+	// strict date time format
+	"isISO8601":     "{field} должно быть строкой даты и времени в формате ISO 8601",
+	"isRFC3339Nano": "{field} должно быть строкой даты и времени в формате RFC 3339",
+	// unix timestamp
+	"isUnixTimestamp": "{field} должно быть допустимой unix-меткой времени",
+	"tsAfter":         "{field} метка времени должна быть после {args0}",
+	"tsBefore":        "{field} метка времени должна быть до {args0}",
+	// age
+	"minAge": "{field} возраст должен быть не менее %d",
+	"maxAge": "{field} возраст должен быть не более %d",
+	// business day
+	"isBusinessDay": "{field} должно быть рабочим днём",
+	"notHoliday":    "{field} не должно быть праздничным днём",
+	// duration
+	"isDuration":  "{field} должно быть строкой длительности",
+	"minDuration": "{field} длительность должна быть не менее %s",
+	"maxDuration": "{field} длительность должна быть не более %s",
+	"isTimezone":  "{field} должно быть допустимым названием часового пояса",
+	"isCronExpr":  "{field} должно быть допустимым cron-выражением, ошибка в поле {cronField}",
 	// check char
 	"hasWhitespace":  "{field} должно содержать пробелы",
 	"ascii":          "{field} должно быть ASCII строкой",
@@ -126,4 +155,163 @@ var Data = map[string]string{
 	"winPath":        "{field} должно быть строкой пути Windows",
 	"isbn10":         "{field} должно быть isbn10 строкой",
 	"isbn13":         "{field} должно быть isbn13 строкой",
+	// phone
+	"isPhone": "{field} должно быть допустимым номером телефона",
+	"isE164":  "{field} должно быть номером телефона в формате E.164",
+	// postal code
+	"postalCode":   "{field} должно быть допустимым почтовым индексом",
+	"isPostalCode": "{field} должно быть допустимым почтовым индексом",
+	// ISO codes
+	"isCountryCode":  "{field} должно быть допустимым кодом страны",
+	"isCurrencyCode": "{field} должно быть допустимым кодом валюты",
+	"isLanguageTag":  "{field} должно быть допустимым языковым тегом",
+	// finance
+	"isCreditCard": "{field} должно быть допустимым номером кредитной карты",
+	"isIBAN":       "{field} должно быть допустимым IBAN",
+	"isBIC":        "{field} должно быть допустимым кодом BIC",
+	"isVATNumber":  "{field} должно быть допустимым номером плательщика НДС",
+	// identity
+	"isNationalID": "{field} должно быть допустимым национальным идентификационным номером",
+	// book and product codes
+	"isISSN":   "{field} должно быть допустимым ISSN",
+	"isEAN8":   "{field} должно быть допустимым штрихкодом EAN-8",
+	"isEAN13":  "{field} должно быть допустимым штрихкодом EAN-13",
+	"isGTIN14": "{field} должно быть допустимым номером GTIN-14",
+	// crypto currency
+	"isBTCAddress":    "{field} должно быть допустимым адресом биткойна",
+	"isETHAddress":    "{field} должно быть допустимым адресом эфириума",
+	"isCryptoAddress": "{field} должно быть допустимым адресом {args0}",
+	// decimal
+	"isDecimal":  "{field} должно быть десятичным числом",
+	"isDecimal1": "{field} должно быть целым числом не более чем из %d цифр",
+	"isDecimal2": "{field} должно быть десятичным числом не более чем из %d цифр и %d знаков после запятой",
+	// geo
+	"isLatitude":  "{field} должно быть координатами широты",
+	"isLongitude": "{field} должно быть координатами долготы",
+	"isLatLng":    "{field} должно быть парой широта,долгота",
+	"isGeoJSON":   "{field} должно быть допустимым объектом GeoJSON",
+	// network
+	"isCIDR":      "{field} должно быть CIDR строкой",
+	"isCIDRv4":    "{field} должно быть CIDRv4 строкой",
+	"isCIDRv6":    "{field} должно быть CIDRv6 строкой",
+	"isIPInRange": "{field} должно быть IP-адресом в диапазоне {values}",
+	"isPort":      "{field} должно быть допустимым номером порта",
+	"isPortRange": "{field} должно быть допустимым диапазоном портов",
+	"isDNSLabel":  "{field} должно быть допустимой меткой DNS",
+	"isHostname":  "{field} должно быть допустимым именем хоста",
+	"isFQDN":      "{field} должно быть полным доменным именем",
+	// DNS lookup
+	"isDomainResolvable": "{field} должно быть разрешаемым доменом",
+	"isEmailMX":          "{field} должно быть адресом электронной почты с почтовым сервером",
+	// disposable email
+	"isEmailNotDisposable": "{field} не должно быть одноразовым адресом электронной почты",
+	// MIME type
+	"isMimeType":   "{field} должно быть корректным MIME типом",
+	"isMimeTypeIn": "{field} должно быть одним из MIME типов {values}",
+	// sortable ID
+	"isULID":  "{field} должно быть корректным ULID",
+	"isKSUID": "{field} должно быть корректным KSUID",
+	"isXID":   "{field} должно быть корректным xid",
+	// snowflake ID
+	"isSnowflake": "{field} должно быть корректным snowflake ID",
+	// encoding
+	"isBase32":      "{field} должно быть корректной base32 строкой",
+	"isBase58":      "{field} должно быть корректной base58 строкой",
+	"isHexPrefixed": "{field} должно быть шестнадцатеричной строкой с префиксом 0x",
+	// password hash
+	"isBcryptHash": "{field} должно быть корректным bcrypt хешем",
+	"isArgon2Hash": "{field} должно быть корректным argon2 хешем",
+	"isPBKDF2Hash": "{field} должно быть корректным PBKDF2 хешем",
+	// PEM
+	"isPEMCert": "{field} должно быть корректным PEM сертификатом",
+	"isPEMKey":  "{field} должно быть корректным PEM ключом",
+	"isPEMCSR":  "{field} должно быть корректным PEM запросом на сертификат",
+	// SSH
+	"isSSHPublicKey": "{field} должно быть корректным SSH публичным ключом",
+	// version
+	"isSemver":      "{field} должно быть корректной семантической версией",
+	"isSemverRange": "{field} должно быть корректным диапазоном версий",
+	// container image
+	"isOCIImageRef": "{field} должно быть корректной ссылкой на образ контейнера",
+	// kubernetes
+	"isK8sName":          "{field} должно быть корректным именем ресурса kubernetes",
+	"isK8sLabelValue":    "{field} должно быть корректным значением метки kubernetes",
+	"isK8sQualifiedName": "{field} должно быть корректным квалифицированным именем kubernetes",
+	// AWS
+	"isAWSARN":   "{field} должно быть корректным AWS ARN",
+	"isS3Bucket": "{field} должно быть корректным именем S3 бакета",
+	// git
+	"isGitSHA":        "{field} должно быть корректным SHA коммита git",
+	"isGitBranchName": "{field} должно быть корректным именем ветки git",
+	"isGitRefName":    "{field} должно быть корректным именем ссылки git",
+	// slug
+	"isSlug": "{field} должно быть корректным slug",
+	// username
+	"isUsername": "{field} не является допустимым или доступным именем пользователя",
+	// password
+	"isPassword": "{field} не соответствует политике паролей: {failed}",
+	// password strength
+	"passwordStrength": "{field} слишком слабый, надёжность должна быть не менее {args0}",
+	// breached password
+	"notPwned": "{field} обнаружен в утечке данных, используйте другой",
+	// one-time code
+	"isOTPCode":     "{field} должно быть действительным одноразовым кодом",
+	"isBackupCode":  "{field} должно быть действительным резервным кодом",
+	"secureEqField": "{field} не совпадает с полем %s",
+	// banned words
+	"isCleanText": "{field} содержит недопустимые слова",
+	// dangerous content
+	"noHTMLTags":   "{field} не может содержать HTML-теги",
+	"noScript":     "{field} не может содержать скрипты",
+	"safeRichText": "{field} содержит недопустимые HTML-теги или атрибуты",
+	// path safety
+	"safePath":   "{field} должно быть безопасным относительным путём",
+	"withinBase": "{field} должно быть путём внутри каталога %s",
+	// admin tooling
+	"isSQLIdentifier": "{field} должно быть допустимым SQL-идентификатором",
+	"isLDAPDN":        "{field} должно быть допустимым LDAP DN",
+	// process input
+	"shellSafe":    "{field} не может содержать специальные символы оболочки",
+	"isEnvVarName": "{field} должно быть допустимым именем переменной окружения",
+	// file system
+	"isFilePath":   "{field} должно быть существующим файлом",
+	"isDirPath":    "{field} должно быть существующим каталогом",
+	"pathReadable": "{field} должно быть доступным для чтения путём",
+	"pathWritable": "{field} должно быть доступным для записи путём",
+	// config text
+	"xmlWellFormed": "{field} должно быть корректным XML, {error}",
+	"yamlParsable":  "{field} должно быть корректным YAML, {error}",
+	// unicode normalization
+	"isNFC": "{field} должно быть в форме нормализации Unicode NFC",
+	// byte length
+	"byteLen":    "Длина {field} должна быть %d байт",
+	"minByteLen": "Длина {field} должна быть не меньше %d байт",
+	"maxByteLen": "Длина {field} должна быть не более %d байт",
+	// homoglyph
+	"noConfusables": "{field} содержит символы, похожие на другие буквы",
+	"singleScript":  "{field} должно использовать буквы одной письменности",
+	// emoji
+	"noEmoji":  "{field} не может содержать эмодзи",
+	"hasEmoji": "{field} должно содержать эмодзи",
+	// slice elements
+	"distinct": "{field} не должно содержать повторяющихся значений, {duplicate} повторяется",
+	"uniqueBy": "{field} не должно содержать повторяющихся значений {args0}, {duplicate} повторяется",
+	// slice set
+	"subsetOf":   "{field} должно содержать только значения из {list}",
+	"supersetOf": "{field} должно содержать все значения из {list}",
+	"intersects": "{field} должно содержать хотя бы одно значение из {list}",
+	// items count
+	"minItems":     "количество элементов {field} должно быть не меньше {args0}",
+	"maxItems":     "количество элементов {field} должно быть не больше {args0}",
+	"itemsBetween": "количество элементов {field} должно быть от {min} до {max}",
+	// contains items
+	"containsAll": "{field} должно содержать все из {list}",
+	"containsAny": "{field} должно содержать хотя бы одно из {list}",
+	// string prefix, suffix
+	"notStartsWith": "{field} не должно начинаться с {affixes}",
+	"notEndsWith":   "{field} не должно заканчиваться на {affixes}",
+	// named regex pattern
+	"pattern": "{field} должно соответствовать шаблону %s",
+	// rule groups
+	"or": "Поле {field} должно пройти одну из групп правил {groups}",
 }
@@ -17,7 +17,11 @@ func RegisterGlobal() {
 
 // Data zh-CN language messages
 var Data = map[string]string{
-	"_": "{field} 没有通过验证",
+	"_":         "{field} 没有通过验证",
+	"_filter":   "{field} 的数据无效: {error}",
+	"_not":      "{field} 不能通过 {rule} 的检查",
+	"_timeout":  "{field} 验证超时",
+	"_fieldRef": "{field} 无法验证, 引用的字段 %s 不存在",
 	// int
 	"min": "{field} 的最小值是 %v",
 	"max": "{field} 的最大值是 %v",
@@ -46,6 +50,11 @@ var Data = map[string]string{
 	"lteField": "{field} 值应小于等于该字段 %s",
 	"gtField":  "{field} 值应大于该字段 %s",
 	"gteField": "{field} 值应大于等于该字段 %s",
+	// field date compare
+	"afterField":         "{field} 日期应该在字段 %s 之后",
+	"afterOrEqualField":  "{field} 日期应该等于字段 %s 或者在其之后",
+	"beforeField":        "{field} 日期应该在字段 %s 之前",
+	"beforeOrEqualField": "{field} 日期应该等于字段 %s 或者在其之前",
 	// check string
 	"isString":     "{field} 值必须是一个字符串",
 	"isString1":    "{field} 值必须是一个字符串，最小长度为 %d",
@@ -64,10 +73,11 @@ var Data = map[string]string{
 	"strings": "{field} 值必须是一个[]string类型",
 	//
 	"notIn":       "{field} 值不能出现在给定枚举列表中 %d",
+	"notEnum":     "{field} 值不能出现在枚举列表中 %v",
 	"contains":    "{field} 值不能出现在枚举列表中 %s",
 	"notContains": "{field} 值包含输入指定值 %s",
-	"startsWith":  "{field} 值的前缀必须是：%s ",
-	"endsWith":    "{field} 值的后缀必须是：%s ",
+	"startsWith":  "{field} 值的前缀必须是：{affixes} ",
+	"endsWith":    "{field} 值的后缀必须是：{affixes} ",
 	"regex":       "{field} 值没有通过正则匹配",
 	"file":        "{field} 值必须是一个文件",
 	"image":       "{field} 值必须是一图像",
@@ -77,6 +87,25 @@ var Data = map[string]string{
 	"ltDate":  "{field} 日期应该在 %s 之前",
 	"gteDate": "{field} 日期应该等于 %s 或者在其之后",
 	"lteDate": "{field} 日期应该等于 %s 或者在其之前",
+	// strict date time format
+	"isISO8601":     "{field} 值应该是一个 ISO 8601 格式的日期时间字符串",
+	"isRFC3339Nano": "{field} 值应该是一个 RFC 3339 格式的日期时间字符串",
+	// unix timestamp
+	"isUnixTimestamp": "{field} 值应该是一个有效的 unix 时间戳",
+	"tsAfter":         "{field} 时间戳应该在 {args0} 之后",
+	"tsBefore":        "{field} 时间戳应该在 {args0} 之前",
+	// age
+	"minAge": "{field} 年龄不能小于 %d 岁",
+	"maxAge": "{field} 年龄不能大于 %d 岁",
+	// business day
+	"isBusinessDay": "{field} 值应该是一个工作日",
+	"notHoliday":    "{field} 值不能是节假日",
+	// duration
+	"isDuration":  "{field} 值应该是一个时长字符串",
+	"minDuration": "{field} 时长不能小于 %s",
+	"maxDuration": "{field} 时长不能大于 %s",
+	"isTimezone":  "{field} 值应该是一个有效的时区名称",
+	"isCronExpr":  "{field} 值应该是一个有效的 cron 表达式，无效的部分: {cronField}",
 	// check char
 	"hasWhitespace":  "{field} 值应该包含空格",
 	"ascii":          "{field} 值应该是一个 ASCII 字符串",
@@ -115,4 +144,163 @@ var Data = map[string]string{
 	"winPath":        "{field} 值应该是一个Windows路径字符串",
 	"isbn10":         "{field} 值应该是一个ISBN10字符串",
 	"isbn13":         "{field} 值应该是一个ISBN13字符串",
+	// phone
+	"isPhone": "{field} 值应该是一个有效的电话号码",
+	"isE164":  "{field} 值应该是一个 E.164 格式的电话号码",
+	// postal code
+	"postalCode":   "{field} 值应该是一个有效的邮政编码",
+	"isPostalCode": "{field} 值应该是一个有效的邮政编码",
+	// ISO codes
+	"isCountryCode":  "{field} 值应该是一个有效的国家代码",
+	"isCurrencyCode": "{field} 值应该是一个有效的货币代码",
+	"isLanguageTag":  "{field} 值应该是一个有效的语言标签",
+	// finance
+	"isCreditCard": "{field} 值应该是一个有效的信用卡号",
+	"isIBAN":       "{field} 值应该是一个有效的 IBAN 账号",
+	"isBIC":        "{field} 值应该是一个有效的 BIC 代码",
+	"isVATNumber":  "{field} 值应该是一个有效的增值税号",
+	// identity
+	"isNationalID": "{field} 值应该是一个有效的身份证件号码",
+	// book and product codes
+	"isISSN":   "{field} 值应该是一个有效的ISSN",
+	"isEAN8":   "{field} 值应该是一个有效的EAN-8条码",
+	"isEAN13":  "{field} 值应该是一个有效的EAN-13条码",
+	"isGTIN14": "{field} 值应该是一个有效的GTIN-14编码",
+	// crypto currency
+	"isBTCAddress":    "{field} 值应该是一个有效的比特币地址",
+	"isETHAddress":    "{field} 值应该是一个有效的以太坊地址",
+	"isCryptoAddress": "{field} 值应该是一个有效的 {args0} 地址",
+	// decimal
+	"isDecimal":  "{field} 值应该是一个十进制数字",
+	"isDecimal1": "{field} 值应该是一个最多 %d 位的整数",
+	"isDecimal2": "{field} 值应该是一个最多 %d 位且最多 %d 位小数的数字",
+	// geo
+	"isLatitude":  "{field} 值应该是一个纬度坐标",
+	"isLongitude": "{field} 值应该是一个经度坐标",
+	"isLatLng":    "{field} 值应该是一个纬度,经度坐标",
+	"isGeoJSON":   "{field} 值应该是一个有效的GeoJSON对象",
+	// network
+	"isCIDR":      "{field} 值应该是一个CIDR字符串",
+	"isCIDRv4":    "{field} 值应该是一个CIDRv4字符串",
+	"isCIDRv6":    "{field} 值应该是一个CIDRv6字符串",
+	"isIPInRange": "{field} 值应该是在 {values} 范围内的IP地址",
+	"isPort":      "{field} 值应该是一个有效的端口号",
+	"isPortRange": "{field} 值应该是一个有效的端口范围",
+	"isDNSLabel":  "{field} 值应该是一个有效的DNS标签",
+	"isHostname":  "{field} 值应该是一个有效的主机名",
+	"isFQDN":      "{field} 值应该是一个完全限定域名",
+	// DNS lookup
+	"isDomainResolvable": "{field} 值应该是一个可解析的域名",
+	"isEmailMX":          "{field} 值应该是一个有邮件服务器的邮箱地址",
+	// disposable email
+	"isEmailNotDisposable": "{field} 值不能是一次性邮箱地址",
+	// MIME type
+	"isMimeType":   "{field} 值必须是有效的MIME类型",
+	"isMimeTypeIn": "{field} 值必须是这些MIME类型之一 {values}",
+	// sortable ID
+	"isULID":  "{field} 值必须是有效的ULID",
+	"isKSUID": "{field} 值必须是有效的KSUID",
+	"isXID":   "{field} 值必须是有效的xid",
+	// snowflake ID
+	"isSnowflake": "{field} 值必须是有效的Snowflake ID",
+	// encoding
+	"isBase32":      "{field} 值必须是有效的base32字符串",
+	"isBase58":      "{field} 值必须是有效的base58字符串",
+	"isHexPrefixed": "{field} 值必须是0x开头的十六进制字符串",
+	// password hash
+	"isBcryptHash": "{field} 值必须是有效的bcrypt哈希",
+	"isArgon2Hash": "{field} 值必须是有效的argon2哈希",
+	"isPBKDF2Hash": "{field} 值必须是有效的PBKDF2哈希",
+	// PEM
+	"isPEMCert": "{field} 值必须是有效的PEM证书",
+	"isPEMKey":  "{field} 值必须是有效的PEM密钥",
+	"isPEMCSR":  "{field} 值必须是有效的PEM证书请求",
+	// SSH
+	"isSSHPublicKey": "{field} 值必须是有效的SSH公钥",
+	// version
+	"isSemver":      "{field} 值必须是有效的语义化版本",
+	"isSemverRange": "{field} 值必须是有效的版本范围",
+	// container image
+	"isOCIImageRef": "{field} 值必须是有效的容器镜像引用",
+	// kubernetes
+	"isK8sName":          "{field} 值必须是有效的Kubernetes资源名称",
+	"isK8sLabelValue":    "{field} 值必须是有效的Kubernetes标签值",
+	"isK8sQualifiedName": "{field} 值必须是有效的Kubernetes限定名称",
+	// AWS
+	"isAWSARN":   "{field} 值必须是有效的AWS ARN",
+	"isS3Bucket": "{field} 值必须是有效的S3存储桶名称",
+	// git
+	"isGitSHA":        "{field} 值必须是有效的git提交SHA",
+	"isGitBranchName": "{field} 值必须是有效的git分支名称",
+	"isGitRefName":    "{field} 值必须是有效的git引用名称",
+	// slug
+	"isSlug": "{field} 值必须是有效的slug",
+	// username
+	"isUsername": "{field} 不是有效或可用的用户名",
+	// password
+	"isPassword": "{field} 不符合密码策略: {failed}",
+	// password strength
+	"passwordStrength": "{field} 强度太弱，强度至少为 {args0}",
+	// breached password
+	"notPwned": "{field} 已出现在数据泄露中，请使用其他的",
+	// one-time code
+	"isOTPCode":     "{field} 必须是有效的一次性验证码",
+	"isBackupCode":  "{field} 必须是有效的备用码",
+	"secureEqField": "{field} 值与字段 %s 不匹配",
+	// banned words
+	"isCleanText": "{field} 包含不当词语",
+	// dangerous content
+	"noHTMLTags":   "{field} 不能包含 HTML 标签",
+	"noScript":     "{field} 不能包含脚本",
+	"safeRichText": "{field} 包含不允许的 HTML 标签或属性",
+	// path safety
+	"safePath":   "{field} 值必须是安全的相对路径",
+	"withinBase": "{field} 值必须是目录 %s 内的路径",
+	// admin tooling
+	"isSQLIdentifier": "{field} 值必须是有效的 SQL 标识符",
+	"isLDAPDN":        "{field} 值必须是有效的 LDAP 识别名",
+	// process input
+	"shellSafe":    "{field} 值不能包含 shell 特殊字符",
+	"isEnvVarName": "{field} 值必须是有效的环境变量名",
+	// file system
+	"isFilePath":   "{field} 值必须是存在的文件",
+	"isDirPath":    "{field} 值必须是存在的目录",
+	"pathReadable": "{field} 值必须是可读的路径",
+	"pathWritable": "{field} 值必须是可写的路径",
+	// config text
+	"xmlWellFormed": "{field} 值必须是格式正确的 XML, {error}",
+	"yamlParsable":  "{field} 值必须是有效的 YAML, {error}",
+	// unicode normalization
+	"isNFC": "{field} 值必须是 Unicode NFC 规范化形式",
+	// byte length
+	"byteLen":    "{field} 的长度必须是 %d 字节",
+	"minByteLen": "{field} 的最小长度是 %d 字节",
+	"maxByteLen": "{field} 的最大长度是 %d 字节",
+	// homoglyph
+	"noConfusables": "{field} 包含与其他字母形似的字符",
+	"singleScript":  "{field} 只能使用同一种文字的字母",
+	// emoji
+	"noEmoji":  "{field} 不能包含表情符号",
+	"hasEmoji": "{field} 必须包含表情符号",
+	// slice elements
+	"distinct": "{field} 不能包含重复的值，{duplicate} 重复了",
+	"uniqueBy": "{field} 的 {args0} 不能包含重复的值，{duplicate} 重复了",
+	// slice set
+	"subsetOf":   "{field} 只能包含 {list} 中的值",
+	"supersetOf": "{field} 必须包含 {list} 中的所有值",
+	"intersects": "{field} 必须至少包含 {list} 中的一个值",
+	// items count
+	"minItems":     "{field} 至少包含 {args0} 项",
+	"maxItems":     "{field} 最多包含 {args0} 项",
+	"itemsBetween": "{field} 包含的项数必须在 {min} 到 {max} 之间",
+	// contains items
+	"containsAll": "{field} 必须包含 {list} 中的全部内容",
+	"containsAny": "{field} 必须至少包含 {list} 中的一项",
+	// string prefix, suffix
+	"notStartsWith": "{field} 值的前缀不能是：{affixes}",
+	"notEndsWith":   "{field} 值的后缀不能是：{affixes}",
+	// named regex pattern
+	"pattern": "{field} 必须匹配 %s 格式",
+	// rule groups
+	"or": "{field} 必须通过其中一组规则 {groups}",
 }
@@ -17,7 +17,11 @@ func RegisterGlobal() {
 
 // Data zh-TW language messages
 var Data = map[string]string{
-	"_": "{field} 沒有通過驗證",
+	"_":         "{field} 沒有通過驗證",
+	"_filter":   "{field} 的資料無效: {error}",
+	"_not":      "{field} 不能通過 {rule} 的檢查",
+	"_timeout":  "{field} 驗證逾時",
+	"_fieldRef": "{field} 無法驗證, 引用的欄位 %s 不存在",
 	// int
 	"min": "{field} 的最小值是 %v",
 	"max": "{field} 的最大值是 %v",
@@ -46,6 +50,11 @@ var Data = map[string]string{
 	"lteField": "{field} 值應小於等於該字段 %s",
 	"gtField":  "{field} 值應大於該字段 %s",
 	"gteField": "{field} 值應大於等於該字段 %s",
+	// field date compare
+	"afterField":         "{field} 日期應該在字段 %s 之後",
+	"afterOrEqualField":  "{field} 日期應該等於字段 %s 或者在其之後",
+	"beforeField":        "{field} 日期應該在字段 %s 之前",
+	"beforeOrEqualField": "{field} 日期應該等於字段 %s 或者在其之前",
 	// check string
 	"isString":     "{field} 值必須是壹個字符串",
 	"isString1":    "{field} 值必須是壹個字符串，最小長度為 %d",
@@ -64,10 +73,11 @@ var Data = map[string]string{
 	"strings": "{field} 值必須是壹個[]string類型",
 	//
 	"notIn":       "{field} 值不能出現在給定枚舉列表中 %d",
+	"notEnum":     "{field} 值不能出現在枚舉列表中 %v",
 	"contains":    "{field} 值不能出現在枚舉列表中 %s",
 	"notContains": "{field} 值包含輸入指定值 %s",
-	"startsWith":  "{field} 值的前綴必須是：%s ",
-	"endsWith":    "{field} 值的後綴必須是：%s ",
+	"startsWith":  "{field} 值的前綴必須是：{affixes} ",
+	"endsWith":    "{field} 值的後綴必須是：{affixes} ",
 	"regex":       "{field} 值沒有通過正則匹配",
 	"file":        "{field} 值必須是壹個文件",
 	"image":       "{field} 值必須是壹圖像",
@@ -77,6 +87,25 @@ var Data = map[string]string{
 	"ltDate":  "{field} 日期應該在 %s 之前",
 	"gteDate": "{field} 日期應該等於 %s 或者在其之後",
 	"lteDate": "{field} 日期應該等於 %s 或者在其之前",
+	// strict date time format
+	"isISO8601":     "{field} 值應該是一個 ISO 8601 格式的日期時間字符串",
+	"isRFC3339Nano": "{field} 值應該是一個 RFC 3339 格式的日期時間字符串",
+	// unix timestamp
+	"isUnixTimestamp": "{field} 值應該是一個有效的 unix 時間戳",
+	"tsAfter":         "{field} 時間戳應該在 {args0} 之後",
+	"tsBefore":        "{field} 時間戳應該在 {args0} 之前",
+	// age
+	"minAge": "{field} 年齡不能小於 %d 歲",
+	"maxAge": "{field} 年齡不能大於 %d 歲",
+	// business day
+	"isBusinessDay": "{field} 值應該是一個工作日",
+	"notHoliday":    "{field} 值不能是節假日",
+	// duration
+	"isDuration":  "{field} 值應該是一個時長字符串",
+	"minDuration": "{field} 時長不能小於 %s",
+	"maxDuration": "{field} 時長不能大於 %s",
+	"isTimezone":  "{field} 值應該是一個有效的時區名稱",
+	"isCronExpr":  "{field} 值應該是一個有效的 cron 表達式，無效的部分: {cronField}",
 	// check char
 	"hasWhitespace":  "{field} 值應該包含空格",
 	"ascii":          "{field} 值應該是壹個 ASCII 字符串",
@@ -115,4 +144,163 @@ var Data = map[string]string{
 	"winPath":        "{field} 值應該是壹個Windows路徑字符串",
 	"isbn10":         "{field} 值應該是壹個ISBN10字符串",
 	"isbn13":         "{field} 值應該是壹個ISBN13字符串",
+	// phone
+	"isPhone": "{field} 值應該是一個有效的電話號碼",
+	"isE164":  "{field} 值應該是一個 E.164 格式的電話號碼",
+	// postal code
+	"postalCode":   "{field} 值應該是一個有效的郵政編碼",
+	"isPostalCode": "{field} 值應該是一個有效的郵政編碼",
+	// ISO codes
+	"isCountryCode":  "{field} 值應該是一個有效的國家代碼",
+	"isCurrencyCode": "{field} 值應該是一個有效的貨幣代碼",
+	"isLanguageTag":  "{field} 值應該是一個有效的語言標籤",
+	// finance
+	"isCreditCard": "{field} 值應該是一個有效的信用卡號",
+	"isIBAN":       "{field} 值應該是一個有效的 IBAN 帳號",
+	"isBIC":        "{field} 值應該是一個有效的 BIC 代碼",
+	"isVATNumber":  "{field} 值應該是一個有效的增值稅號",
+	// identity
+	"isNationalID": "{field} 值應該是一個有效的身份證件號碼",
+	// book and product codes
+	"isISSN":   "{field} 值應該是一個有效的ISSN",
+	"isEAN8":   "{field} 值應該是一個有效的EAN-8條碼",
+	"isEAN13":  "{field} 值應該是一個有效的EAN-13條碼",
+	"isGTIN14": "{field} 值應該是一個有效的GTIN-14編碼",
+	// crypto currency
+	"isBTCAddress":    "{field} 值應該是一個有效的比特幣地址",
+	"isETHAddress":    "{field} 值應該是一個有效的以太坊地址",
+	"isCryptoAddress": "{field} 值應該是一個有效的 {args0} 地址",
+	// decimal
+	"isDecimal":  "{field} 值應該是一個十進位數字",
+	"isDecimal1": "{field} 值應該是一個最多 %d 位的整數",
+	"isDecimal2": "{field} 值應該是一個最多 %d 位且最多 %d 位小數的數字",
+	// geo
+	"isLatitude":  "{field} 值應該是一個緯度坐標",
+	"isLongitude": "{field} 值應該是一個經度坐標",
+	"isLatLng":    "{field} 值應該是一個緯度,經度坐標",
+	"isGeoJSON":   "{field} 值應該是一個有效的GeoJSON物件",
+	// network
+	"isCIDR":      "{field} 值應該是一個CIDR字串",
+	"isCIDRv4":    "{field} 值應該是一個CIDRv4字串",
+	"isCIDRv6":    "{field} 值應該是一個CIDRv6字串",
+	"isIPInRange": "{field} 值應該是在 {values} 範圍內的IP位址",
+	"isPort":      "{field} 值應該是一個有效的連接埠號",
+	"isPortRange": "{field} 值應該是一個有效的連接埠範圍",
+	"isDNSLabel":  "{field} 值應該是一個有效的DNS標籤",
+	"isHostname":  "{field} 值應該是一個有效的主機名稱",
+	"isFQDN":      "{field} 值應該是一個完整網域名稱",
+	// DNS lookup
+	"isDomainResolvable": "{field} 值應該是一個可解析的網域",
+	"isEmailMX":          "{field} 值應該是一個有郵件伺服器的電子郵件地址",
+	// disposable email
+	"isEmailNotDisposable": "{field} 值不能是一次性電子郵件地址",
+	// MIME type
+	"isMimeType":   "{field} 值必須是有效的MIME類型",
+	"isMimeTypeIn": "{field} 值必須是這些MIME類型之壹 {values}",
+	// sortable ID
+	"isULID":  "{field} 值必須是有效的ULID",
+	"isKSUID": "{field} 值必須是有效的KSUID",
+	"isXID":   "{field} 值必須是有效的xid",
+	// snowflake ID
+	"isSnowflake": "{field} 值必須是有效的Snowflake ID",
+	// encoding
+	"isBase32":      "{field} 值必須是有效的base32字符串",
+	"isBase58":      "{field} 值必須是有效的base58字符串",
+	"isHexPrefixed": "{field} 值必須是0x開頭的十六進制字符串",
+	// password hash
+	"isBcryptHash": "{field} 值必須是有效的bcrypt雜湊",
+	"isArgon2Hash": "{field} 值必須是有效的argon2雜湊",
+	"isPBKDF2Hash": "{field} 值必須是有效的PBKDF2雜湊",
+	// PEM
+	"isPEMCert": "{field} 值必須是有效的PEM證書",
+	"isPEMKey":  "{field} 值必須是有效的PEM密鑰",
+	"isPEMCSR":  "{field} 值必須是有效的PEM證書請求",
+	// SSH
+	"isSSHPublicKey": "{field} 值必須是有效的SSH公鑰",
+	// version
+	"isSemver":      "{field} 值必須是有效的語義化版本",
+	"isSemverRange": "{field} 值必須是有效的版本範圍",
+	// container image
+	"isOCIImageRef": "{field} 值必須是有效的容器鏡像引用",
+	// kubernetes
+	"isK8sName":          "{field} 值必須是有效的Kubernetes資源名稱",
+	"isK8sLabelValue":    "{field} 值必須是有效的Kubernetes標籤值",
+	"isK8sQualifiedName": "{field} 值必須是有效的Kubernetes限定名稱",
+	// AWS
+	"isAWSARN":   "{field} 值必須是有效的AWS ARN",
+	"isS3Bucket": "{field} 值必須是有效的S3儲存桶名稱",
+	// git
+	"isGitSHA":        "{field} 值必須是有效的git提交SHA",
+	"isGitBranchName": "{field} 值必須是有效的git分支名稱",
+	"isGitRefName":    "{field} 值必須是有效的git引用名稱",
+	// slug
+	"isSlug": "{field} 值必須是有效的slug",
+	// username
+	"isUsername": "{field} 不是有效或可用的用戶名",
+	// password
+	"isPassword": "{field} 不符合密碼策略: {failed}",
+	// password strength
+	"passwordStrength": "{field} 強度太弱，強度至少為 {args0}",
+	// breached password
+	"notPwned": "{field} 已出現在數據洩露中，請使用其他的",
+	// one-time code
+	"isOTPCode":     "{field} 必須是有效的一次性驗證碼",
+	"isBackupCode":  "{field} 必須是有效的備用碼",
+	"secureEqField": "{field} 值與字段 %s 不匹配",
+	// banned words
+	"isCleanText": "{field} 包含不當詞語",
+	// dangerous content
+	"noHTMLTags":   "{field} 不能包含 HTML 標籤",
+	"noScript":     "{field} 不能包含腳本",
+	"safeRichText": "{field} 包含不允許的 HTML 標籤或屬性",
+	// path safety
+	"safePath":   "{field} 值必須是安全的相對路徑",
+	"withinBase": "{field} 值必須是目錄 %s 內的路徑",
+	// admin tooling
+	"isSQLIdentifier": "{field} 值必須是有效的 SQL 識別符",
+	"isLDAPDN":        "{field} 值必須是有效的 LDAP 識別名",
+	// process input
+	"shellSafe":    "{field} 值不能包含 shell 特殊字元",
+	"isEnvVarName": "{field} 值必須是有效的環境變數名",
+	// file system
+	"isFilePath":   "{field} 值必須是存在的檔案",
+	"isDirPath":    "{field} 值必須是存在的目錄",
+	"pathReadable": "{field} 值必須是可讀的路徑",
+	"pathWritable": "{field} 值必須是可寫的路徑",
+	// config text
+	"xmlWellFormed": "{field} 值必須是格式正確的 XML, {error}",
+	"yamlParsable":  "{field} 值必須是有效的 YAML, {error}",
+	// unicode normalization
+	"isNFC": "{field} 值必須是 Unicode NFC 正規化形式",
+	// byte length
+	"byteLen":    "{field} 的長度必須是 %d 位元組",
+	"minByteLen": "{field} 的最小長度是 %d 位元組",
+	"maxByteLen": "{field} 的最大長度是 %d 位元組",
+	// homoglyph
+	"noConfusables": "{field} 包含與其他字母形似的字元",
+	"singleScript":  "{field} 只能使用同一種文字的字母",
+	// emoji
+	"noEmoji":  "{field} 不能包含表情符號",
+	"hasEmoji": "{field} 必須包含表情符號",
+	// slice elements
+	"distinct": "{field} 不能包含重複的值，{duplicate} 重複了",
+	"uniqueBy": "{field} 的 {args0} 不能包含重複的值，{duplicate} 重複了",
+	// slice set
+	"subsetOf":   "{field} 只能包含 {list} 中的值",
+	"supersetOf": "{field} 必須包含 {list} 中的所有值",
+	"intersects": "{field} 必須至少包含 {list} 中的一個值",
+	// items count
+	"minItems":     "{field} 至少包含 {args0} 項",
+	"maxItems":     "{field} 最多包含 {args0} 項",
+	"itemsBetween": "{field} 包含的項數必須在 {min} 到 {max} 之間",
+	// contains items
+	"containsAll": "{field} 必須包含 {list} 中的全部內容",
+	"containsAny": "{field} 必須至少包含 {list} 中的一項",
+	// string prefix, suffix
+	"notStartsWith": "{field} 值的前綴不能是：{affixes}",
+	"notEndsWith":   "{field} 值的後綴不能是：{affixes}",
+	// named regex pattern
+	"pattern": "{field} 必須匹配 %s 格式",
+	// rule groups
+	"or": "{field} 必須通過其中一組規則 {groups}",
 }
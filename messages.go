@@ -136,8 +136,11 @@ func (es Errors) FieldOne(field string) string {
 var builtinMessages = map[string]string{
 	"_": "{field}" + defaultErrMsg, // default message
 	// builtin
-	"_validate": "{field} did not pass validate", // default validate message
-	"_filter":   "{field} data is invalid",       // data filter error
+	"_validate": "{field} did not pass validate",    // default validate message
+	"_filter":   "{field} data is invalid: {error}", // data filter error
+	"_not":      "{field} must not pass the {rule} check",
+	"_timeout":  "{field} validation timeout",
+	"_fieldRef": "{field} value cannot be checked, the referenced field %s is missing",
 	// int value
 	"min": "{field} min value is %v",
 	"max": "{field} max value is %v",
@@ -185,6 +188,11 @@ var builtinMessages = map[string]string{
 	"lteField": "{field} value should be less than or equal to field %s",
 	"gtField":  "{field} value must be greater the field %s",
 	"gteField": "{field} value should be greater or equal to field %s",
+	// field date compare
+	"afterField":         "{field} value should be after the field %s",
+	"afterOrEqualField":  "{field} value should be after or equal to the field %s",
+	"beforeField":        "{field} value should be before the field %s",
+	"beforeOrEqualField": "{field} value should be before or equal to the field %s",
 	// data type
 	"bool":    "{field} value must be a bool",
 	"float":   "{field} value must be a float",
@@ -193,11 +201,13 @@ var builtinMessages = map[string]string{
 	"array":   "{field} value  must be an array",
 	"strings": "{field} value must be a []string",
 	"notIn":   "{field} value must not in the given enum list %d",
+	// registered enum sets
+	"notEnum": "{field} value must not be in the enum %v",
 	//
 	"contains":    "{field} value does not contain this %s",
 	"notContains": "{field} value contains the given %s",
-	"startsWith":  "{field} value does not start with the given %s",
-	"endsWith":    "{field} value does not end with the given %s",
+	"startsWith":  "{field} value does not start with the given {affixes}",
+	"endsWith":    "{field} value does not end with the given {affixes}",
 	"email":       "{field} value is invalid mail",
 	"regex":       "{field} value does not pass regex check",
 	"file":        "{field} value must be a file",
@@ -208,6 +218,25 @@ var builtinMessages = map[string]string{
 	"ltDate":  "{field} value should be before %s",
 	"gteDate": "{field} value should be after or equal to %s",
 	"lteDate": "{field} value should be before or equal to %s",
+	// strict date time format
+	"isISO8601":     "{field} value should be an ISO 8601 date time string",
+	"isRFC3339Nano": "{field} value should be an RFC 3339 date time string",
+	// unix timestamp
+	"isUnixTimestamp": "{field} value should be a valid unix timestamp",
+	"tsAfter":         "{field} timestamp should be after {args0}",
+	"tsBefore":        "{field} timestamp should be before {args0}",
+	// age
+	"minAge": "{field} age must be at least %d",
+	"maxAge": "{field} age must be at most %d",
+	// business day
+	"isBusinessDay": "{field} value should be a business day",
+	"notHoliday":    "{field} value should not be a holiday",
+	// duration
+	"isDuration":  "{field} value should be a duration string",
+	"minDuration": "{field} duration must be at least %s",
+	"maxDuration": "{field} duration must be at most %s",
+	"isTimezone":  "{field} value should be a valid time zone name",
+	"isCronExpr":  "{field} value should be a valid cron expression, invalid at the {cronField}",
 	// check char
 	"hasWhitespace":  "{field} value should contains spaces",
 	"ascii":          "{field} value should be an ASCII string",
@@ -246,6 +275,165 @@ var builtinMessages = map[string]string{
 	"winPath":        "{field} value should be a windows path string",
 	"isbn10":         "{field} value should be a isbn10 string",
 	"isbn13":         "{field} value should be a isbn13 string",
+	// phone
+	"isPhone": "{field} value should be a valid phone number",
+	"isE164":  "{field} value should be a phone number in E.164 format",
+	// postal code
+	"postalCode":   "{field} value should be a valid postal code",
+	"isPostalCode": "{field} value should be a valid postal code",
+	// ISO codes
+	"isCountryCode":  "{field} value should be a valid country code",
+	"isCurrencyCode": "{field} value should be a valid currency code",
+	"isLanguageTag":  "{field} value should be a valid language tag",
+	// finance
+	"isCreditCard": "{field} value should be a valid credit card number",
+	"isIBAN":       "{field} value should be a valid IBAN",
+	"isBIC":        "{field} value should be a valid BIC code",
+	"isVATNumber":  "{field} value should be a valid VAT number",
+	// identity
+	"isNationalID": "{field} value should be a valid national ID number",
+	// book and product codes
+	"isISSN":   "{field} value should be a valid ISSN",
+	"isEAN8":   "{field} value should be a valid EAN-8 barcode",
+	"isEAN13":  "{field} value should be a valid EAN-13 barcode",
+	"isGTIN14": "{field} value should be a valid GTIN-14 number",
+	// crypto currency
+	"isBTCAddress":    "{field} value should be a valid bitcoin address",
+	"isETHAddress":    "{field} value should be a valid ethereum address",
+	"isCryptoAddress": "{field} value should be a valid {args0} address",
+	// decimal
+	"isDecimal":  "{field} value should be a decimal number",
+	"isDecimal1": "{field} value should be an integer with at most %d digits",
+	"isDecimal2": "{field} value should be a decimal number with at most %d digits and %d decimal places",
+	// geo
+	"isLatitude":  "{field} value should be latitude coordinates",
+	"isLongitude": "{field} value should be longitude coordinates",
+	"isLatLng":    "{field} value should be a latitude,longitude pair",
+	"isGeoJSON":   "{field} value should be a valid GeoJSON object",
+	// network
+	"isCIDR":      "{field} value should be a CIDR string",
+	"isCIDRv4":    "{field} value should be a CIDRv4 string",
+	"isCIDRv6":    "{field} value should be a CIDRv6 string",
+	"isIPInRange": "{field} value should be an IP address within {values}",
+	"isPort":      "{field} value should be a valid port number",
+	"isPortRange": "{field} value should be a valid port range",
+	"isDNSLabel":  "{field} value should be a valid DNS label",
+	"isHostname":  "{field} value should be a valid hostname",
+	"isFQDN":      "{field} value should be a fully qualified domain name",
+	// DNS lookup
+	"isDomainResolvable": "{field} value should be a resolvable domain",
+	"isEmailMX":          "{field} value should be an email address with a mail server",
+	// disposable email
+	"isEmailNotDisposable": "{field} value should not be a disposable email address",
+	// MIME type
+	"isMimeType":   "{field} value should be a valid MIME type",
+	"isMimeTypeIn": "{field} value should be one of the MIME types {values}",
+	// sortable ID
+	"isULID":  "{field} value should be a valid ULID",
+	"isKSUID": "{field} value should be a valid KSUID",
+	"isXID":   "{field} value should be a valid xid",
+	// snowflake ID
+	"isSnowflake": "{field} value should be a valid snowflake ID",
+	// encoding
+	"isBase32":      "{field} value should be a valid base32 string",
+	"isBase58":      "{field} value should be a valid base58 string",
+	"isHexPrefixed": "{field} value should be a hex string with the 0x prefix",
+	// password hash
+	"isBcryptHash": "{field} value should be a valid bcrypt hash",
+	"isArgon2Hash": "{field} value should be a valid argon2 hash",
+	"isPBKDF2Hash": "{field} value should be a valid PBKDF2 hash",
+	// PEM
+	"isPEMCert": "{field} value should be a valid PEM certificate",
+	"isPEMKey":  "{field} value should be a valid PEM key",
+	"isPEMCSR":  "{field} value should be a valid PEM certificate request",
+	// SSH
+	"isSSHPublicKey": "{field} value should be a valid SSH public key",
+	// version
+	"isSemver":      "{field} value should be a valid semantic version",
+	"isSemverRange": "{field} value should be a valid version range",
+	// container image
+	"isOCIImageRef": "{field} value should be a valid container image reference",
+	// kubernetes
+	"isK8sName":          "{field} value should be a valid kubernetes resource name",
+	"isK8sLabelValue":    "{field} value should be a valid kubernetes label value",
+	"isK8sQualifiedName": "{field} value should be a valid kubernetes qualified name",
+	// AWS
+	"isAWSARN":   "{field} value should be a valid AWS ARN",
+	"isS3Bucket": "{field} value should be a valid S3 bucket name",
+	// git
+	"isGitSHA":        "{field} value should be a valid git commit SHA",
+	"isGitBranchName": "{field} value should be a valid git branch name",
+	"isGitRefName":    "{field} value should be a valid git reference name",
+	// slug
+	"isSlug": "{field} value should be a valid slug",
+	// username
+	"isUsername": "{field} value is not a valid or available username",
+	// password
+	"isPassword": "{field} value does not meet the password policy: {failed}",
+	// password strength
+	"passwordStrength": "{field} value is too weak, the strength should be at least {args0}",
+	// breached password
+	"notPwned": "{field} value has appeared in a data breach, please use another one",
+	// one-time code
+	"isOTPCode":     "{field} value should be a valid one-time code",
+	"isBackupCode":  "{field} value should be a valid backup code",
+	"secureEqField": "{field} value does not match the field %s",
+	// banned words
+	"isCleanText": "{field} contains inappropriate words",
+	// dangerous content
+	"noHTMLTags":   "{field} cannot contain HTML tags",
+	"noScript":     "{field} cannot contain scripts",
+	"safeRichText": "{field} contains disallowed HTML tags or attributes",
+	// path safety
+	"safePath":   "{field} value should be a safe relative path",
+	"withinBase": "{field} value should be a path within the dir %s",
+	// admin tooling
+	"isSQLIdentifier": "{field} value should be a valid SQL identifier",
+	"isLDAPDN":        "{field} value should be a valid LDAP distinguished name",
+	// process input
+	"shellSafe":    "{field} value cannot contain the shell special chars",
+	"isEnvVarName": "{field} value should be a valid environment variable name",
+	// file system
+	"isFilePath":   "{field} value should be an existing file",
+	"isDirPath":    "{field} value should be an existing directory",
+	"pathReadable": "{field} value should be a readable path",
+	"pathWritable": "{field} value should be a writable path",
+	// config text
+	"xmlWellFormed": "{field} value should be a well-formed XML, {error}",
+	"yamlParsable":  "{field} value should be a valid YAML, {error}",
+	// unicode normalization
+	"isNFC": "{field} value should be in the Unicode NFC form",
+	// byte length
+	"byteLen":    "{field} length must be %d bytes",
+	"minByteLen": "{field} min length is %d bytes",
+	"maxByteLen": "{field} max length is %d bytes",
+	// homoglyph
+	"noConfusables": "{field} contains the characters that look like other letters",
+	"singleScript":  "{field} must use the letters of a single script",
+	// emoji
+	"noEmoji":  "{field} cannot contain emoji",
+	"hasEmoji": "{field} must contain an emoji",
+	// slice elements
+	"distinct": "{field} must not contain duplicate values, {duplicate} is duplicated",
+	"uniqueBy": "{field} must not contain duplicate {args0} values, {duplicate} is duplicated",
+	// slice set
+	"subsetOf":   "{field} must only contain the values in {list}",
+	"supersetOf": "{field} must contain all the values in {list}",
+	"intersects": "{field} must contain at least one of the values in {list}",
+	// items count
+	"minItems":     "{field} must contain at least {args0} {items}",
+	"maxItems":     "{field} must contain at most {args0} {items}",
+	"itemsBetween": "{field} must contain between {min} and {max} {items}",
+	// contains items
+	"containsAll": "{field} must contain all of {list}",
+	"containsAny": "{field} must contain at least one of {list}",
+	// string prefix, suffix
+	"notStartsWith": "{field} value must not start with {affixes}",
+	"notEndsWith":   "{field} value must not end with {affixes}",
+	// named regex pattern
+	"pattern": "{field} must match the %s pattern",
+	// rule groups
+	"or": "{field} must pass one of the rule groups {groups}",
 }
 
 // AddGlobalMessages add global builtin messages
@@ -274,6 +462,62 @@ func BuiltinMessages() map[string]string {
 // StdTranslator for default. TODO
 // var StdTranslator = NewTranslator()
 
+// the extra params builder for the error message of the validator. key is the real validator name.
+// the params can be used in the message by "{name}". eg: "{cronField}"
+var messageParamFuncs = map[string]func(val interface{}, args []interface{}) map[string]string{
+	"isCronExpr":   cronExprMessageParams,
+	"isCreditCard": creditCardMessageParams,
+	"isPassword":   passwordMessageParams,
+	"distinct":     distinctMessageParams,
+	"uniqueBy":     uniqueByMessageParams,
+	"subsetOf":     listMessageParams,
+	"supersetOf":   listMessageParams,
+	"intersects":   listMessageParams,
+	"minItems":     itemsMessageParams,
+	"maxItems":     itemsMessageParams,
+	"itemsBetween": itemsMessageParams,
+	"containsAll":  listMessageParams,
+	"containsAny":  listMessageParams,
+	// string prefix, suffix
+	"startsWith":        affixMessageParams,
+	"endsWith":          affixMessageParams,
+	"notStartsWith":     affixMessageParams,
+	"notEndsWith":       affixMessageParams,
+	"startsWithFold":    affixMessageParams,
+	"endsWithFold":      affixMessageParams,
+	"notStartsWithFold": affixMessageParams,
+	"notEndsWithFold":   affixMessageParams,
+	// config text
+	"xmlWellFormed": textParseMessageParams(checkXMLWellFormed),
+	"yamlParsable":  textParseMessageParams(checkYAMLParsable),
+	// rule groups
+	"or": groupsMessageParams,
+}
+
+// the extra message params for the prefix, suffix validators. {affixes}: the joined args. eg: "img_, pic_"
+func affixMessageParams(_ interface{}, args []interface{}) map[string]string {
+	ss := make([]string, len(args))
+	for i, arg := range args {
+		ss[i] = strutil.MustString(arg)
+	}
+	return map[string]string{"affixes": strings.Join(ss, ", ")}
+}
+
+// replace the extra params in the error message.
+func applyMessageParams(msg, validator string, val interface{}, args []interface{}) string {
+	fn, ok := messageParamFuncs[validator]
+	if !ok || !strings.ContainsRune(msg, '{') {
+		return msg
+	}
+
+	params := fn(val, args)
+	pairs := make([]string, 0, len(params)*2)
+	for name, pv := range params {
+		pairs = append(pairs, "{"+name+"}", pv)
+	}
+	return strings.NewReplacer(pairs...).Replace(msg)
+}
+
 // Translator definition
 type Translator struct {
 	// the field output name, use for Errors key.
@@ -407,6 +651,11 @@ func (t *Translator) Message(validator, field string, args ...interface{}) (msg
 			errMsg = t.findMessage(rName, field, argLen)
 		}
 
+		// negated rule. eg: "not:in" use the "_not" message
+		if errMsg == "" && strings.HasPrefix(validator, negatePrefix) {
+			errMsg = strings.Replace(t.findMessage("_not", field, argLen), "{rule}", validator[len(negatePrefix):], 1)
+		}
+
 		// not found, fallback - use default error message
 		if errMsg == "" {
 			return t.LabelName(field) + defaultErrMsg
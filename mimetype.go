@@ -0,0 +1,104 @@
+package validate
+
+import "strings"
+
+// the max length of the MIME type and subtype name. see RFC 6838 section 4.2
+const maxMimeNameLen = 127
+
+// parse the MIME type with the optional parameters. eg: "text/html; charset=utf-8"
+// returns the lower case "type/subtype" without parameters.
+func parseMimeType(s string) (string, bool) {
+	params := splitMimeParams(s)
+	mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+
+	pos := strings.IndexByte(mediaType, '/')
+	if pos < 0 || !isMimeName(mediaType[:pos]) || !isMimeName(mediaType[pos+1:]) {
+		return "", false
+	}
+
+	for _, param := range params[1:] {
+		if !isMimeParam(strings.TrimSpace(param)) {
+			return "", false
+		}
+	}
+	return mediaType, true
+}
+
+// split the MIME type by ';', the ';' in the quoted-string is not separator.
+func splitMimeParams(s string) []string {
+	var parts []string
+	var quoted bool
+
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && quoted:
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == ';' && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// check the MIME type matches the patterns. the pattern can be "image/*"
+func matchMimeType(mime string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if pattern == mime || pattern == "*/*" {
+			return true
+		}
+		if strings.HasSuffix(pattern, "/*") && strings.HasPrefix(mime, pattern[:len(pattern)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// restricted-name = restricted-name-first *126restricted-name-chars. see RFC 6838 section 4.2
+func isMimeName(s string) bool {
+	if s == "" || len(s) > maxMimeNameLen || !isAlnum(s[0]) {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		if !isAlnum(s[i]) && !strings.ContainsRune("!#$&-^_.+", rune(s[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// parameter = token "=" ( token / quoted-string ). see RFC 7231 section 3.1.1.1
+func isMimeParam(s string) bool {
+	pos := strings.IndexByte(s, '=')
+	if pos <= 0 || !isHTTPToken(s[:pos]) {
+		return false
+	}
+
+	value := s[pos+1:]
+	if strings.HasPrefix(value, `"`) {
+		return isQuotedString(value)
+	}
+	return isHTTPToken(value)
+}
+
+// token = 1*tchar. see RFC 7230 section 3.2.6
+func isHTTPToken(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isAlnum(s[i]) && !strings.ContainsRune("!#$%&'*+-.^_`|~", rune(s[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
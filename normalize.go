@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ToNFC filter, normalize the string to the Unicode NFC form. eg: "é" -> "é"
+//
+// Usage:
+// 	v.FilterRule("username", "nfc")
+func ToNFC(s string) string {
+	return norm.NFC.String(s)
+}
+
+// ToNFKC filter, normalize the string to the Unicode NFKC form, the compatibility chars are replaced.
+// eg: "ｆｉ" -> "fi", "①" -> "1"
+func ToNFKC(s string) string {
+	return norm.NFKC.String(s)
+}
+
+// ToLowerLocale filter, convert the string to lower case by the language rules.
+// eg: Turkish "I" -> "ı", "İ" -> "i". the language is optional, default use the Unicode default rules.
+//
+// Usage:
+// 	v.FilterRule("username", "lowerLocale:tr")
+func ToLowerLocale(s string, lang ...string) string {
+	tag, ok := caseLanguage(lang)
+	if !ok {
+		return s
+	}
+	return cases.Lower(tag).String(s)
+}
+
+// ToUpperLocale filter, convert the string to upper case by the language rules.
+// eg: Azerbaijani "i" -> "İ", "ı" -> "I"
+//
+// Usage:
+// 	v.FilterRule("code", "upperLocale:az")
+func ToUpperLocale(s string, lang ...string) string {
+	tag, ok := caseLanguage(lang)
+	if !ok {
+		return s
+	}
+	return cases.Upper(tag).String(s)
+}
+
+// parse the language tag for the case filters
+func caseLanguage(lang []string) (language.Tag, bool) {
+	if len(lang) == 0 || lang[0] == "" {
+		return language.Und, true
+	}
+
+	tag, err := language.Parse(lang[0])
+	if err != nil {
+		configErrorf("invalid language '%s' for the case filter: %s", lang[0], err.Error())
+		return language.Und, false
+	}
+	return tag, true
+}
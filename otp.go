@@ -0,0 +1,27 @@
+package validate
+
+import (
+	"crypto/subtle"
+	"regexp"
+)
+
+// the digit length range of the one-time code. the RFC 4226 requires at least 6 digits,
+// but the SMS and email codes are commonly 4 digits.
+const (
+	minOTPDigits = 4
+	maxOTPDigits = 10
+)
+
+// the hyphenated recovery code, 2 - 4 groups of 4 - 8 letters or digits. eg: "a1b2-c3d4", "1234-5678-9012"
+var rxBackupCode = regexp.MustCompile(`^[a-zA-Z0-9]{4,8}(?:-[a-zA-Z0-9]{4,8}){1,3}$`)
+
+// ConstantTimeEqual check the two strings are equal in constant time.
+// use it to compare the secret values, eg: the submitted one-time code and the stored code.
+//
+// Usage:
+// 	if !validate.ConstantTimeEqual(input.Code, session.Code) {
+// 		// the code does not match ...
+// 	}
+func ConstantTimeEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,116 @@
+package validate
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// the bcrypt hash: "$2b$<cost>$<22 chars salt><31 chars hash>", the cost is 04 - 31
+var rxBcryptHash = regexp.MustCompile(`^\$2[abxy]?\$(0[4-9]|[12]\d|3[01])\$[./A-Za-z0-9]{53}$`)
+
+// the salt and hash of the PHC string and the modular crypt. the base64 without padding,
+// the "." is used instead of "+" by the passlib.
+var rxHashB64 = regexp.MustCompile(`^[A-Za-z0-9+/.]+$`)
+
+// check the argon2 hash of the PHC string format.
+// eg: "$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$RdescudvJCsgt3ub+b+dWRWJTmaaJObG"
+func checkArgon2Hash(s string) bool {
+	parts := strings.Split(s, "$")
+	if len(parts) < 5 || parts[0] != "" {
+		return false
+	}
+
+	switch parts[1] {
+	case "argon2i", "argon2d", "argon2id":
+	default:
+		return false
+	}
+
+	// the version segment is optional
+	if len(parts) == 6 {
+		if parts[2] != "v=16" && parts[2] != "v=19" {
+			return false
+		}
+		parts = append(parts[:2], parts[3:]...)
+	}
+	if len(parts) != 5 {
+		return false
+	}
+
+	params, ok := parseHashParams(parts[2], "m", "t", "p")
+	if !ok || params["t"] < 1 || params["p"] < 1 || params["p"] > 1<<24-1 || params["m"] < 8*params["p"] {
+		return false
+	}
+	return rxHashB64.MatchString(parts[3]) && rxHashB64.MatchString(parts[4])
+}
+
+// check the PBKDF2 hash. support the formats:
+// 	PHC string:    "$pbkdf2-sha256$i=29000,l=32$<salt>$<hash>"
+// 	modular crypt: "$pbkdf2-sha256$29000$<salt>$<hash>"
+// 	django:        "pbkdf2_sha256$260000$<salt>$<hash>"
+func checkPBKDF2Hash(s string) bool {
+	parts := strings.Split(s, "$")
+	if len(parts) == 4 && strings.HasPrefix(parts[0], "pbkdf2_") {
+		// the django format, the hash has the padding
+		digest := parts[0][len("pbkdf2_"):]
+		return isPBKDF2Digest(digest) && isPositiveInt(parts[1]) && rxHashB64.MatchString(parts[2]) &&
+			rxHashB64.MatchString(strings.TrimRight(parts[3], "="))
+	}
+
+	if len(parts) != 5 || parts[0] != "" || !strings.HasPrefix(parts[1], "pbkdf2") {
+		return false
+	}
+
+	digest := strings.TrimPrefix(parts[1], "pbkdf2")
+	if digest != "" && (digest[0] != '-' || !isPBKDF2Digest(digest[1:])) {
+		return false
+	}
+
+	if !isPositiveInt(parts[2]) {
+		params, ok := parseHashParams(parts[2], "i", "l")
+		if !ok {
+			// the key length is optional
+			params, ok = parseHashParams(parts[2], "i")
+		}
+		if !ok || params["i"] < 1 {
+			return false
+		}
+	}
+	return rxHashB64.MatchString(parts[3]) && rxHashB64.MatchString(parts[4])
+}
+
+func isPBKDF2Digest(digest string) bool {
+	switch digest {
+	case "sha1", "sha224", "sha256", "sha384", "sha512":
+		return true
+	}
+	return false
+}
+
+func isPositiveInt(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n > 0 && isDigits(s)
+}
+
+// parse the PHC params "k1=v1,k2=v2", the keys must be in order and the values are integer.
+func parseHashParams(s string, keys ...string) (map[string]int, bool) {
+	pairs := strings.Split(s, ",")
+	if len(pairs) != len(keys) {
+		return nil, false
+	}
+
+	params := make(map[string]int, len(keys))
+	for i, pair := range pairs {
+		if !strings.HasPrefix(pair, keys[i]+"=") || !isDigits(pair[len(keys[i])+1:]) {
+			return nil, false
+		}
+
+		n, err := strconv.Atoi(pair[len(keys[i])+1:])
+		if err != nil {
+			return nil, false
+		}
+		params[keys[i]] = n
+	}
+	return params, true
+}
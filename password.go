@@ -0,0 +1,257 @@
+package validate
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gookit/goutil/strutil"
+)
+
+// the password policy of the password rule. see IsPassword()
+type passwordPolicy struct {
+	min, max int
+	// the min count of the char classes
+	upper, lower, digit, symbol int
+	// the max count of the consecutive identical chars. 0 is unlimited
+	maxRepeat int
+}
+
+// parse the password policy options. eg: "min=12", "upper", "digit=2", "maxRepeat=3"
+func parsePasswordPolicy(options []string) (p passwordPolicy, ok bool) {
+	p.min = 8
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		key, val := option, ""
+		if pos := strings.IndexByte(option, '='); pos > 0 {
+			key, val = option[:pos], option[pos+1:]
+		}
+
+		var ptr *int
+		switch key {
+		case "":
+			continue
+		case "min":
+			ptr = &p.min
+		case "max":
+			ptr = &p.max
+		case "upper":
+			ptr = &p.upper
+		case "lower":
+			ptr = &p.lower
+		case "digit":
+			ptr = &p.digit
+		case "symbol":
+			ptr = &p.symbol
+		case "maxRepeat":
+			ptr = &p.maxRepeat
+		default:
+			configErrorf("invalid password option '%s', allow: min, max, upper, lower, digit, symbol, maxRepeat", option)
+			return p, false
+		}
+
+		// the char class without count require at least one
+		if val == "" && key != "min" && key != "max" && key != "maxRepeat" {
+			*ptr = 1
+			continue
+		}
+
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 1 {
+			configErrorf("invalid password option '%s', the value must be a positive integer", option)
+			return p, false
+		}
+		*ptr = n
+	}
+
+	if p.max > 0 && p.max < p.min {
+		configErrorf("invalid password options, the max %d is less than the min %d", p.max, p.min)
+		return p, false
+	}
+	return p, true
+}
+
+// check the password by the policy, returns the failed policy components. eg: ["min", "symbol"]
+func (p passwordPolicy) failures(s string) []string {
+	var failed []string
+	if n := utf8.RuneCountInString(s); n < p.min {
+		failed = append(failed, "min")
+	} else if p.max > 0 && n > p.max {
+		failed = append(failed, "max")
+	}
+
+	var upper, lower, digit, symbol, repeat, maxRepeat int
+	var last rune
+	for i, r := range []rune(s) {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsDigit(r):
+			digit++
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol++
+		}
+
+		if i > 0 && r == last {
+			repeat++
+		} else {
+			repeat = 1
+		}
+		if repeat > maxRepeat {
+			maxRepeat = repeat
+		}
+		last = r
+	}
+
+	counts := []struct {
+		name      string
+		got, want int
+	}{
+		{"upper", upper, p.upper},
+		{"lower", lower, p.lower},
+		{"digit", digit, p.digit},
+		{"symbol", symbol, p.symbol},
+	}
+	for _, c := range counts {
+		if c.got < c.want {
+			failed = append(failed, c.name)
+		}
+	}
+
+	if p.maxRepeat > 0 && maxRepeat > p.maxRepeat {
+		failed = append(failed, "maxRepeat")
+	}
+	return failed
+}
+
+// the message params of the password rule. "{failed}" is the failed policy components. eg: "min,symbol"
+func passwordMessageParams(val interface{}, args []interface{}) map[string]string {
+	options := make([]string, 0, len(args))
+	for _, arg := range args {
+		options = append(options, strutil.MustString(arg))
+	}
+
+	str, _ := val.(string)
+	p, _ := parsePasswordPolicy(options)
+	return map[string]string{"failed": strings.Join(p.failures(str), ",")}
+}
+
+// StrengthEstimator estimate the password strength score, 0 - 4 like the zxcvbn. see SetStrengthEstimator()
+type StrengthEstimator interface {
+	// Score returns the strength score of the password, 0 is the weakest and 4 is the strongest.
+	Score(password string) int
+}
+
+// the common passwords, they are scored 0 by the EntropyEstimator
+var commonPasswords = map[string]bool{
+	"password": true, "password1": true, "123456": true, "12345678": true, "123456789": true,
+	"1234567890": true, "qwerty": true, "qwerty123": true, "abc123": true, "111111": true,
+	"letmein": true, "welcome": true, "iloveyou": true, "admin": true, "monkey": true,
+	"dragon": true, "football": true, "baseball": true, "sunshine": true, "princess": true,
+}
+
+// the entropy bits thresholds of the score 1 - 4
+var entropyScoreBits = [4]float64{28, 36, 60, 80}
+
+// EntropyEstimator is the simple entropy based StrengthEstimator, it is the default estimator.
+//
+// The entropy is "length * log2(charset size)", the consecutive identical chars are counted once
+// and the common passwords are scored 0.
+type EntropyEstimator struct{}
+
+// Score returns the strength score of the password. implements the StrengthEstimator
+func (EntropyEstimator) Score(password string) int {
+	if password == "" || commonPasswords[strings.ToLower(password)] {
+		return 0
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol, hasOther bool
+	var length int
+	var last rune
+	for i, r := range []rune(password) {
+		switch {
+		case r >= 'a' && r <= 'z':
+			hasLower = true
+		case r >= 'A' && r <= 'Z':
+			hasUpper = true
+		case r >= '0' && r <= '9':
+			hasDigit = true
+		case r < utf8.RuneSelf:
+			hasSymbol = true
+		default:
+			hasOther = true
+		}
+
+		if i == 0 || r != last {
+			length++
+		}
+		last = r
+	}
+
+	var pool int
+	for _, c := range []struct {
+		has  bool
+		size int
+	}{{hasLower, 26}, {hasUpper, 26}, {hasDigit, 10}, {hasSymbol, 33}, {hasOther, 100}} {
+		if c.has {
+			pool += c.size
+		}
+	}
+
+	bits := float64(length) * math.Log2(float64(pool))
+	score := 0
+	for _, threshold := range entropyScoreBits {
+		if bits >= threshold {
+			score++
+		}
+	}
+	return score
+}
+
+// custom password strength estimator
+var strengthEstimator StrengthEstimator = EntropyEstimator{}
+
+// SetStrengthEstimator set the password strength estimator for the passwordStrength rule.
+// set nil will reset to the EntropyEstimator.
+//
+// Usage:
+// 	validate.SetStrengthEstimator(myZxcvbnEstimator)
+func SetStrengthEstimator(e StrengthEstimator) {
+	if e == nil {
+		e = EntropyEstimator{}
+	}
+	strengthEstimator = e
+}
+
+// BreachChecker check the password is in the known data breaches. see SetBreachChecker()
+//
+// eg: the checker backed by the HIBP k-anonymity API, only the first 5 chars of the SHA-1 hash are sent.
+type BreachChecker interface {
+	// IsPwned returns true on the password has been breached.
+	IsPwned(password string) (bool, error)
+}
+
+// BreachCheckerFunc is the func adapter of the BreachChecker.
+type BreachCheckerFunc func(password string) (bool, error)
+
+// IsPwned implements the BreachChecker
+func (fn BreachCheckerFunc) IsPwned(password string) (bool, error) {
+	return fn(password)
+}
+
+// the breach checker of the notPwned rule, there is no built-in checker.
+var breachChecker BreachChecker
+
+// SetBreachChecker set the breach checker for the notPwned rule. set nil to remove it.
+//
+// Usage:
+// 	validate.SetBreachChecker(validate.BreachCheckerFunc(func(password string) (bool, error) {
+// 		// query the HIBP range API with caching ...
+// 	}))
+func SetBreachChecker(c BreachChecker) {
+	breachChecker = c
+}
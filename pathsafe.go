@@ -0,0 +1,88 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// check the path contains the ".." segment. both "/" and "\" are the separators.
+func hasPathTraversal(s string) bool {
+	for _, seg := range strings.FieldsFunc(s, isPathSeparator) {
+		if seg == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+func isPathSeparator(r rune) bool {
+	return r == '/' || r == '\\'
+}
+
+// check the path is absolute on unix or windows. eg: "/etc", "C:\Windows", "C:file", "\\server\share"
+func isAnyAbsPath(s string) bool {
+	if s == "" {
+		return false
+	}
+	if isPathSeparator(rune(s[0])) {
+		return true
+	}
+	// the windows drive letter
+	return len(s) >= 2 && s[1] == ':' && isASCIILetter(s[0])
+}
+
+// check the path contains the null byte or the control chars.
+func hasControlChar(s string) bool {
+	return strings.IndexFunc(s, unicode.IsControl) >= 0
+}
+
+// resolve the absolute path and the symlinks. the not exists part of the path is kept as is.
+func resolvePath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err == nil {
+		return resolved, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	// resolve the parent dir, the root always exists
+	dir, name := filepath.Split(path)
+	if dir = filepath.Clean(dir); dir == path {
+		return path, nil
+	}
+
+	if dir, err = resolvePath(dir); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// check the path is contained in the base dir, after the symlinks resolved.
+// the relative path is relative to the base dir.
+func isWithinBase(path, base string) bool {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(base, path)
+	}
+
+	base, err := resolvePath(base)
+	if err != nil {
+		return false
+	}
+	if path, err = resolvePath(path); err != nil {
+		return false
+	}
+
+	rel, err := filepath.Rel(base, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var (
+	patternMu sync.RWMutex
+	// the registered regex patterns. see RegisterRegex()
+	patterns = make(map[string]*regexp.Regexp)
+)
+
+// RegisterRegex register the named regex pattern, it is compiled once and shared by the "pattern" rule.
+// register the same name will override it.
+//
+// Usage:
+// 	validate.RegisterRegex("zipCN", `^\d{6}$`)
+// 	v.StringRule("zip", "required|pattern:zipCN")
+func RegisterRegex(name, pattern string) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		configErrorf("the regex pattern name is required")
+		return
+	}
+
+	rx, err := regexp.Compile(pattern)
+	if err != nil {
+		configErrorf("invalid regex pattern '%s' for the name '%s': %s", pattern, name, err.Error())
+		return
+	}
+
+	patternMu.Lock()
+	patterns[name] = rx
+	patternMu.Unlock()
+}
+
+// RegexOf get the registered regex pattern by name.
+func RegexOf(name string) (rx *regexp.Regexp, ok bool) {
+	patternMu.RLock()
+	rx, ok = patterns[name]
+	patternMu.RUnlock()
+	return
+}
+
+// Pattern check the string matches the registered regex pattern. see RegisterRegex()
+//
+// Usage:
+// 	Pattern("100000", "zipCN")
+func Pattern(s, name string) bool {
+	rx, ok := RegexOf(name)
+	if !ok {
+		configErrorf("the regex pattern '%s' is not registered", name)
+		return false
+	}
+	return rx.MatchString(s)
+}
@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	"github.com/gookit/goutil/arrutil"
+)
+
+// the options of the PEM rules. see IsPEMCert()
+type pemOptions struct {
+	keyTypes   []string
+	notExpired bool
+}
+
+// parse the PEM rule options. allow the key types: rsa, ecdsa(ec), ed25519. the "notExpired" is only for cert.
+func parsePEMOptions(options []string, forCert bool) (opt pemOptions, ok bool) {
+	for _, name := range options {
+		switch name = strings.TrimSpace(name); name {
+		case "":
+		case "rsa", "ecdsa", "ed25519":
+			opt.keyTypes = append(opt.keyTypes, name)
+		case "ec":
+			opt.keyTypes = append(opt.keyTypes, "ecdsa")
+		case "notExpired":
+			if forCert {
+				opt.notExpired = true
+				continue
+			}
+			fallthrough
+		default:
+			configErrorf("invalid PEM option '%s', allow: rsa, ecdsa, ed25519 and notExpired(only for cert)", name)
+			return opt, false
+		}
+	}
+	return opt, true
+}
+
+// check the public key type is in the allowed types. the empty types allow all.
+func (opt pemOptions) allowKey(key interface{}) bool {
+	if len(opt.keyTypes) == 0 {
+		return true
+	}
+
+	var typ string
+	switch key.(type) {
+	case *rsa.PublicKey, *rsa.PrivateKey:
+		typ = "rsa"
+	case *ecdsa.PublicKey, *ecdsa.PrivateKey:
+		typ = "ecdsa"
+	case ed25519.PublicKey, ed25519.PrivateKey:
+		typ = "ed25519"
+	}
+
+	return arrutil.StringsHas(opt.keyTypes, typ)
+}
+
+// decode all PEM blocks of the string. returns false on there is no block,
+// the block type is not allowed, or there is non-PEM content.
+func decodePEMBlocks(s string, types ...string) ([]*pem.Block, bool) {
+	var blocks []*pem.Block
+	rest := []byte(s)
+	for {
+		block, remain := pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if !arrutil.StringsHas(types, block.Type) {
+			return nil, false
+		}
+		blocks = append(blocks, block)
+		rest = remain
+	}
+	return blocks, len(blocks) > 0 && strings.TrimSpace(string(rest)) == ""
+}
+
+// parse the private or public key of the PEM block.
+func parsePEMKey(block *pem.Block) (interface{}, error) {
+	switch block.Type {
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	// "PUBLIC KEY"
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
@@ -0,0 +1,205 @@
+package validate
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// the max digits of the E.164 phone number, include the country code.
+const maxE164Len = 15
+
+// phone number metadata of a region
+type phoneMeta struct {
+	// country calling code. eg: "1", "86"
+	code string
+	// national (trunk) prefix. eg: "0"
+	prefix string
+	// national significant number pattern
+	rx *regexp.Regexp
+}
+
+func newPhoneMeta(code, prefix, pattern string) *phoneMeta {
+	return &phoneMeta{code: code, prefix: prefix, rx: regexp.MustCompile(`^(?:` + pattern + `)$`)}
+}
+
+// phone number metadata of the regions. key is ISO 3166-1 alpha-2 region code.
+var phoneRegions = map[string]*phoneMeta{
+	"AU": newPhoneMeta("61", "0", `[2-478]\d{8}`),
+	"BR": newPhoneMeta("55", "0", `[1-9]{2}9?\d{8}`),
+	"CA": newPhoneMeta("1", "1", `[2-9]\d{2}[2-9]\d{6}`),
+	"CN": newPhoneMeta("86", "0", `1[3-9]\d{9}|10\d{8}|[2-9]\d{8,10}`),
+	"DE": newPhoneMeta("49", "0", `[1-9]\d{5,13}`),
+	"ES": newPhoneMeta("34", "", `[5-9]\d{8}`),
+	"FR": newPhoneMeta("33", "0", `[1-9]\d{8}`),
+	"GB": newPhoneMeta("44", "0", `[1-9]\d{8,9}`),
+	"HK": newPhoneMeta("852", "", `[2-9]\d{7}`),
+	"IN": newPhoneMeta("91", "0", `[1-9]\d{9}`),
+	"IT": newPhoneMeta("39", "", `0\d{5,10}|3\d{8,9}`),
+	"JP": newPhoneMeta("81", "0", `[1-9]\d{8,9}`),
+	"KR": newPhoneMeta("82", "0", `[1-9]\d{7,9}`),
+	"MX": newPhoneMeta("52", "", `[1-9]\d{9}`),
+	"NL": newPhoneMeta("31", "0", `[1-9]\d{8}`),
+	"RU": newPhoneMeta("7", "8", `[3489]\d{9}`),
+	"SG": newPhoneMeta("65", "", `[3689]\d{7}`),
+	"TW": newPhoneMeta("886", "0", `[2-9]\d{7,8}`),
+	"US": newPhoneMeta("1", "1", `[2-9]\d{2}[2-9]\d{6}`),
+}
+
+// RegisterPhoneRegion register or override the phone number metadata of a region.
+// the pattern is the regexp for the national significant number(without the national prefix).
+//
+// Usage:
+// 	validate.RegisterPhoneRegion("NZ", "64", "0", `[2-9]\d{7,9}`)
+func RegisterPhoneRegion(region, countryCode, nationalPrefix, pattern string) {
+	rx, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		configErrorf("invalid phone number pattern for the region '%s': %s", region, err.Error())
+		return
+	}
+
+	phoneRegions[strings.ToUpper(region)] = &phoneMeta{code: countryCode, prefix: nationalPrefix, rx: rx}
+}
+
+// ToE164 filter, normalize the phone number to E.164 format. eg: "+12125550100".
+// the region is optional, default is "auto". will return the raw value on parse failed.
+//
+// Usage:
+// 	v.FilterRule("phone", "toE164:US")
+func ToE164(s string, region ...string) string {
+	if e164, ok := parsePhone(s, phoneRegionArg(region)); ok {
+		return e164
+	}
+	return s
+}
+
+func phoneRegionArg(region []string) string {
+	if len(region) > 0 && region[0] != "" {
+		return region[0]
+	}
+	return "auto"
+}
+
+// parse the phone number by the region metadata, returns E.164 format number.
+// on the region is "auto", the number must be in the international format. eg: "+44 20 7946 0958"
+func parsePhone(s, region string) (string, bool) {
+	var meta *phoneMeta
+	if region = strings.ToUpper(region); region != "AUTO" {
+		if meta = phoneRegions[region]; meta == nil {
+			configErrorf("the phone region '%s' is not registered", region)
+			return "", false
+		}
+	}
+
+	num, intl := cleanPhone(s)
+	if num == "" || len(num) > maxE164Len {
+		return "", false
+	}
+
+	if intl {
+		if meta != nil {
+			return matchPhone(num, meta)
+		}
+
+		for _, m := range phoneRegions {
+			if e164, ok := matchPhone(num, m); ok {
+				return e164, true
+			}
+		}
+		return "", false
+	}
+
+	// the national format number, must be given the region.
+	if meta == nil {
+		return "", false
+	}
+	if meta.rx.MatchString(num) {
+		return "+" + meta.code + num, len(meta.code)+len(num) <= maxE164Len
+	}
+	// with the national prefix. eg: "020 7946 0958"
+	return matchPhone(meta.code+num, meta)
+}
+
+// match the international number(without "+") by the region metadata.
+func matchPhone(num string, meta *phoneMeta) (string, bool) {
+	if len(num) > maxE164Len || !strings.HasPrefix(num, meta.code) {
+		return "", false
+	}
+
+	nsn := num[len(meta.code):]
+	if meta.rx.MatchString(nsn) {
+		return "+" + num, true
+	}
+
+	// the national prefix is kept in the international format. eg: "+44 (0)20 7946 0958"
+	if meta.prefix != "" && strings.HasPrefix(nsn, meta.prefix) {
+		if nsn = nsn[len(meta.prefix):]; meta.rx.MatchString(nsn) {
+			return "+" + meta.code + nsn, true
+		}
+	}
+	return "", false
+}
+
+// remove the separators of the phone number. returns the digits and whether it is international format.
+func cleanPhone(s string) (num string, intl bool) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "+") {
+		s, intl = s[1:], true
+	}
+
+	var sb strings.Builder
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			sb.WriteRune(c)
+		case c == ' ' || c == '-' || c == '.' || c == '(' || c == ')' || c == '/':
+		default:
+			return "", false
+		}
+	}
+	return sb.String(), intl
+}
+
+// the assigned country calling codes of the E.164. "-" is the range of codes.
+const e164CountryCodes = "1 7 20 27 30-34 36 39-41 43-49 51-58 60-66 81 82 84 86 90-95 98 " +
+	"211-213 216 218 220-258 260-269 290 291 297-299 350-359 370-378 380-383 385-387 389 " +
+	"420 421 423 500-509 590-599 670 672-683 685-692 800 808 850 852 853 855 856 870 " +
+	"880-883 886 888 960-968 970-977 979 992-996 998"
+
+var (
+	e164CodeOnce sync.Once
+	e164CodeSet  map[string]bool
+)
+
+// check the country calling code is assigned.
+func isE164CountryCode(code string) bool {
+	e164CodeOnce.Do(func() {
+		e164CodeSet = make(map[string]bool, 256)
+		for _, item := range strings.Fields(e164CountryCodes) {
+			nodes := strings.SplitN(item, "-", 2)
+			if len(nodes) == 1 {
+				e164CodeSet[item] = true
+				continue
+			}
+
+			start, _ := strconv.Atoi(nodes[0])
+			end, _ := strconv.Atoi(nodes[1])
+			for i := start; i <= end; i++ {
+				e164CodeSet[strconv.Itoa(i)] = true
+			}
+		}
+	})
+
+	return e164CodeSet[code]
+}
+
+// check the E.164 number has an assigned country calling code. the country code is 1-3 digits.
+func hasE164CountryCode(num string) bool {
+	for i := 1; i <= 3 && i < len(num); i++ {
+		if isE164CountryCode(num[:i]) {
+			return true
+		}
+	}
+	return false
+}
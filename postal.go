@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+)
+
+func newPostalPattern(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(`^(?:` + pattern + `)$`)
+}
+
+// postal code patterns of the countries. key is ISO 3166-1 alpha-2 country code.
+var postalCodePatterns = map[string]*regexp.Regexp{
+	"AR": newPostalPattern(`[A-Z]?\d{4}(?:[A-Z]{3})?`),
+	"AT": newPostalPattern(`[1-9]\d{3}`),
+	"AU": newPostalPattern(`\d{4}`),
+	"BE": newPostalPattern(`[1-9]\d{3}`),
+	"BR": newPostalPattern(`\d{5}-?\d{3}`),
+	"CA": newPostalPattern(`[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d`),
+	"CH": newPostalPattern(`[1-9]\d{3}`),
+	"CN": newPostalPattern(`\d{6}`),
+	"CZ": newPostalPattern(`\d{3} ?\d{2}`),
+	"DE": newPostalPattern(`\d{5}`),
+	"DK": newPostalPattern(`\d{4}`),
+	"ES": newPostalPattern(`(?:0[1-9]|[1-4]\d|5[0-2])\d{3}`),
+	"FI": newPostalPattern(`\d{5}`),
+	"FR": newPostalPattern(`\d{5}`),
+	"GB": newPostalPattern(`GIR ?0AA|[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}`),
+	"IE": newPostalPattern(`(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[0-9AC-FHKNPRTV-Y]{4}`),
+	"IN": newPostalPattern(`[1-9]\d{2} ?\d{3}`),
+	"IT": newPostalPattern(`\d{5}`),
+	"JP": newPostalPattern(`\d{3}-?\d{4}`),
+	"KR": newPostalPattern(`\d{5}`),
+	"MX": newPostalPattern(`\d{5}`),
+	"NL": newPostalPattern(`[1-9]\d{3} ?[A-Z]{2}`),
+	"NO": newPostalPattern(`\d{4}`),
+	"NZ": newPostalPattern(`\d{4}`),
+	"PL": newPostalPattern(`\d{2}-\d{3}`),
+	"PT": newPostalPattern(`\d{4}-\d{3}`),
+	"RU": newPostalPattern(`\d{6}`),
+	"SE": newPostalPattern(`\d{3} ?\d{2}`),
+	"SG": newPostalPattern(`\d{6}`),
+	"TW": newPostalPattern(`\d{3}(?:\d{2,3})?`),
+	"US": newPostalPattern(`\d{5}(?:-\d{4})?`),
+	"ZA": newPostalPattern(`\d{4}`),
+}
+
+// RegisterPostalCode register or override the postal code pattern of a country.
+//
+// Usage:
+// 	validate.RegisterPostalCode("LT", `LT-\d{5}`)
+func RegisterPostalCode(country, pattern string) {
+	rx, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		configErrorf("invalid postal code pattern for the country '%s': %s", country, err.Error())
+		return
+	}
+
+	postalCodePatterns[strings.ToUpper(country)] = rx
+}
+
+// match the postal code by the country pattern. the letters are case-insensitive.
+func matchPostalCode(s, country string) (match, found bool) {
+	rx, ok := postalCodePatterns[strings.ToUpper(country)]
+	if !ok {
+		return false, false
+	}
+	return rx.MatchString(strings.ToUpper(s)), true
+}
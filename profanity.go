@@ -0,0 +1,163 @@
+package validate
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// the built-in banned words, only the common english profanity.
+const bannedWordTable = `
+arsehole asshole bastard bitch bollocks bullshit cock cunt dick dickhead
+fuck fucker fucking motherfucker nigger pussy shit slut twat wanker whore
+`
+
+// the l33t-speak chars and the letters they stand for.
+var leetChars = map[rune]rune{
+	'0': 'o', '1': 'i', '2': 'z', '3': 'e', '4': 'a', '5': 's', '6': 'g', '7': 't', '8': 'b', '9': 'g',
+	'@': 'a', '$': 's', '!': 'i', '|': 'l', '+': 't', '€': 'e',
+}
+
+// BannedWordProvider check the normalized word is banned. see SetBannedWordProvider()
+//
+// The word passed to the provider is lower case, the accents are removed and the l33t-speak is decoded.
+type BannedWordProvider interface {
+	IsBanned(word string) bool
+}
+
+// IsBanned check the word is banned. implements the BannedWordProvider
+func (nl *NameList) IsBanned(word string) bool {
+	return nl.Has(word)
+}
+
+var (
+	bannedOnce sync.Once
+	bannedList *NameList
+	// custom banned word provider
+	bannedProvider BannedWordProvider
+)
+
+// BannedWords get the built-in banned word list, can add or remove words of it.
+//
+// Usage:
+// 	validate.BannedWords().Add("darn", "heck")
+func BannedWords() *NameList {
+	bannedOnce.Do(func() {
+		bannedList = NewNameList(strings.Fields(bannedWordTable)...)
+	})
+	return bannedList
+}
+
+// SetBannedWordProvider set the custom banned word provider for the cleanText rule.
+// set nil will reset to the built-in list.
+func SetBannedWordProvider(p BannedWordProvider) {
+	bannedProvider = p
+}
+
+// check the word is banned by the provider.
+func isBannedWord(word string) bool {
+	if bannedProvider != nil {
+		return bannedProvider.IsBanned(word)
+	}
+	return BannedWords().IsBanned(word)
+}
+
+// check the text contains the banned words. the words are normalized to defeat the l33t-speak,
+// eg: "Sh1t", "$h!t", "f.u.c.k", "f u c k" and "fuuuck".
+func hasBannedWord(s string) bool {
+	for _, word := range normalizeWords(s) {
+		if isBannedWord(word) {
+			return true
+		}
+
+		if collapsed := collapseRepeats(word); collapsed != word && isBannedWord(collapsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// split the text to the normalized words. the words are lower case, the accents are removed
+// and the l33t-speak chars are decoded. the spelled out letters are joined to a word.
+func normalizeWords(s string) []string {
+	var words, letters []string
+	flushLetters := func() {
+		if len(letters) > 1 {
+			words = append(words, strings.Join(letters, ""))
+		}
+		letters = letters[:0]
+	}
+
+	fields := strings.FieldsFunc(norm.NFKD.String(strings.ToLower(s)), func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(`,;:?"'()[]{}<>/\`, r)
+	})
+	for _, field := range fields {
+		// the l33t-speak chars at the end are the punctuation. eg: "shit!"
+		field = strings.TrimRightFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if strings.IndexFunc(field, unicode.IsLetter) < 0 {
+			flushLetters()
+			continue
+		}
+
+		parts := decodeLeetWord(field)
+		if len(parts) == 1 && len(parts[0]) == 1 {
+			letters = append(letters, parts[0])
+			continue
+		}
+
+		flushLetters()
+		words = append(words, parts...)
+		if len(parts) > 1 {
+			// the word is joined by the separators. eg: "f.u.c.k", "mother-fucker"
+			words = append(words, strings.Join(parts, ""))
+		}
+	}
+
+	flushLetters()
+	return words
+}
+
+// decode the l33t-speak word, returns the parts split by the separators ".-_"
+func decodeLeetWord(field string) []string {
+	var parts []string
+	var sb strings.Builder
+	for _, r := range field {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case unicode.IsLetter(r):
+			sb.WriteRune(r)
+		case r == '.' || r == '-' || r == '_':
+			if sb.Len() > 0 {
+				parts = append(parts, sb.String())
+				sb.Reset()
+			}
+		default:
+			if lr, ok := leetChars[r]; ok {
+				sb.WriteRune(lr)
+			}
+		}
+	}
+
+	if sb.Len() > 0 {
+		parts = append(parts, sb.String())
+	}
+	return parts
+}
+
+// collapse the repeated letters. eg: "fuuuck" -> "fuck"
+func collapseRepeats(word string) string {
+	var sb strings.Builder
+	var last rune
+	for i, r := range word {
+		if i > 0 && r == last {
+			continue
+		}
+		sb.WriteRune(r)
+		last = r
+	}
+	return sb.String()
+}
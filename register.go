@@ -27,6 +27,8 @@ var validatorValues = map[string]reflect.Value{
 	"gt":  reflect.ValueOf(Gt),
 	"min": reflect.ValueOf(Min),
 	"max": reflect.ValueOf(Max),
+	// decimal
+	"isDecimal": reflect.ValueOf(IsDecimal),
 	// value check
 	"enum":     reflect.ValueOf(Enum),
 	"notIn":    reflect.ValueOf(NotIn),
@@ -42,6 +44,8 @@ var validatorValues = map[string]reflect.Value{
 	"stringContains": reflect.ValueOf(StringContains),
 	"startsWith":     reflect.ValueOf(StartsWith),
 	"endsWith":       reflect.ValueOf(EndsWith),
+	"notStartsWith":  reflect.ValueOf(NotStartsWith),
+	"notEndsWith":    reflect.ValueOf(NotEndsWith),
 	// data type check
 	"isInt":     reflect.ValueOf(IsInt),
 	"isMap":     reflect.ValueOf(IsMap),
@@ -87,6 +91,144 @@ var validatorValues = map[string]reflect.Value{
 	"isNumber":    reflect.ValueOf(IsNumber),
 	"isNumeric":   reflect.ValueOf(IsNumeric),
 	"isCnMobile":  reflect.ValueOf(IsCnMobile),
+	// phone
+	"isPhone": reflect.ValueOf(IsPhone),
+	"isE164":  reflect.ValueOf(IsE164),
+	// postal code
+	"isPostalCode": reflect.ValueOf(IsPostalCode),
+	// ISO codes
+	"isCountryCode":  reflect.ValueOf(IsCountryCode),
+	"isCurrencyCode": reflect.ValueOf(IsCurrencyCode),
+	"isLanguageTag":  reflect.ValueOf(IsLanguageTag),
+	// finance
+	"isCreditCard": reflect.ValueOf(IsCreditCard),
+	"isIBAN":       reflect.ValueOf(IsIBAN),
+	"isBIC":        reflect.ValueOf(IsBIC),
+	"isVATNumber":  reflect.ValueOf(IsVATNumber),
+	// book and product codes
+	"isISSN":   reflect.ValueOf(IsISSN),
+	"isEAN8":   reflect.ValueOf(IsEAN8),
+	"isEAN13":  reflect.ValueOf(IsEAN13),
+	"isGTIN14": reflect.ValueOf(IsGTIN14),
+	// crypto currency
+	"isBTCAddress":    reflect.ValueOf(IsBTCAddress),
+	"isETHAddress":    reflect.ValueOf(IsETHAddress),
+	"isCryptoAddress": reflect.ValueOf(IsCryptoAddress),
+	// identity
+	"isNationalID": reflect.ValueOf(IsNationalID),
+	// geo
+	"isLatLng":  reflect.ValueOf(IsLatLng),
+	"isGeoJSON": reflect.ValueOf(IsGeoJSON),
+	// network
+	"isIPInRange": reflect.ValueOf(IsIPInRange),
+	"isPort":      reflect.ValueOf(IsPort),
+	"isPortRange": reflect.ValueOf(IsPortRange),
+	"isDNSLabel":  reflect.ValueOf(IsDNSLabel),
+	"isHostname":  reflect.ValueOf(IsHostname),
+	"isFQDN":      reflect.ValueOf(IsFQDN),
+	// DNS lookup
+	"isDomainResolvable": reflect.ValueOf(IsDomainResolvable),
+	"isEmailMX":          reflect.ValueOf(IsEmailMX),
+	// disposable email
+	"isEmailNotDisposable": reflect.ValueOf(IsEmailNotDisposable),
+	// MIME type
+	"isMimeType":   reflect.ValueOf(IsMimeType),
+	"isMimeTypeIn": reflect.ValueOf(IsMimeTypeIn),
+	// sortable ID
+	"isULID":  reflect.ValueOf(IsULID),
+	"isKSUID": reflect.ValueOf(IsKSUID),
+	"isXID":   reflect.ValueOf(IsXID),
+	// snowflake ID
+	"isSnowflake": reflect.ValueOf(IsSnowflake),
+	// encoding
+	"isBase32":      reflect.ValueOf(IsBase32),
+	"isBase58":      reflect.ValueOf(IsBase58),
+	"isHexPrefixed": reflect.ValueOf(IsHexPrefixed),
+	// password hash
+	"isBcryptHash": reflect.ValueOf(IsBcryptHash),
+	"isArgon2Hash": reflect.ValueOf(IsArgon2Hash),
+	"isPBKDF2Hash": reflect.ValueOf(IsPBKDF2Hash),
+	// PEM
+	"isPEMCert": reflect.ValueOf(IsPEMCert),
+	"isPEMKey":  reflect.ValueOf(IsPEMKey),
+	"isPEMCSR":  reflect.ValueOf(IsPEMCSR),
+	// SSH
+	"isSSHPublicKey": reflect.ValueOf(IsSSHPublicKey),
+	// version
+	"isSemver":      reflect.ValueOf(IsSemver),
+	"isSemverRange": reflect.ValueOf(IsSemverRange),
+	// container image
+	"isOCIImageRef": reflect.ValueOf(IsOCIImageRef),
+	// kubernetes
+	"isK8sName":          reflect.ValueOf(IsK8sName),
+	"isK8sLabelValue":    reflect.ValueOf(IsK8sLabelValue),
+	"isK8sQualifiedName": reflect.ValueOf(IsK8sQualifiedName),
+	// AWS
+	"isAWSARN":   reflect.ValueOf(IsAWSARN),
+	"isS3Bucket": reflect.ValueOf(IsS3Bucket),
+	// git
+	"isGitSHA":        reflect.ValueOf(IsGitSHA),
+	"isGitBranchName": reflect.ValueOf(IsGitBranchName),
+	"isGitRefName":    reflect.ValueOf(IsGitRefName),
+	// slug
+	"isSlug": reflect.ValueOf(IsSlug),
+	// username
+	"isUsername": reflect.ValueOf(IsUsername),
+	// password
+	"isPassword":       reflect.ValueOf(IsPassword),
+	"passwordStrength": reflect.ValueOf(PasswordStrength),
+	"notPwned":         reflect.ValueOf(NotPwned),
+	// one-time code
+	"isOTPCode":    reflect.ValueOf(IsOTPCode),
+	"isBackupCode": reflect.ValueOf(IsBackupCode),
+	// banned words
+	"isCleanText": reflect.ValueOf(IsCleanText),
+	// dangerous content
+	"noHTMLTags":   reflect.ValueOf(NoHTMLTags),
+	"noScript":     reflect.ValueOf(NoScript),
+	"safeRichText": reflect.ValueOf(SafeRichText),
+	// admin tooling
+	"isSQLIdentifier": reflect.ValueOf(IsSQLIdentifier),
+	"isLDAPDN":        reflect.ValueOf(IsLDAPDN),
+	// process input
+	"shellSafe":    reflect.ValueOf(ShellSafe),
+	"isEnvVarName": reflect.ValueOf(IsEnvVarName),
+	// config text
+	"xmlWellFormed": reflect.ValueOf(XMLWellFormed),
+	"yamlParsable":  reflect.ValueOf(YAMLParsable),
+	// unicode normalization
+	"isNFC": reflect.ValueOf(IsNFC),
+	// byte length
+	"byteLen":    reflect.ValueOf(ByteLen),
+	"minByteLen": reflect.ValueOf(MinByteLen),
+	"maxByteLen": reflect.ValueOf(MaxByteLen),
+	// homoglyph
+	"noConfusables": reflect.ValueOf(NoConfusables),
+	"singleScript":  reflect.ValueOf(SingleScript),
+	// emoji
+	"noEmoji":  reflect.ValueOf(NoEmoji),
+	"hasEmoji": reflect.ValueOf(HasEmoji),
+	// slice elements
+	"distinct": reflect.ValueOf(Distinct),
+	"uniqueBy": reflect.ValueOf(UniqueBy),
+	// slice set
+	"subsetOf":   reflect.ValueOf(SubsetOf),
+	"supersetOf": reflect.ValueOf(SupersetOf),
+	"intersects": reflect.ValueOf(Intersects),
+	// items count
+	"minItems":     reflect.ValueOf(MinItems),
+	"maxItems":     reflect.ValueOf(MaxItems),
+	"itemsBetween": reflect.ValueOf(ItemsBetween),
+	// contains items
+	"containsAll": reflect.ValueOf(ContainsAll),
+	"containsAny": reflect.ValueOf(ContainsAny),
+	// string prefix, suffix. case-insensitive
+	"startsWithFold":    reflect.ValueOf(StartsWithFold),
+	"endsWithFold":      reflect.ValueOf(EndsWithFold),
+	"notStartsWithFold": reflect.ValueOf(NotStartsWithFold),
+	"notEndsWithFold":   reflect.ValueOf(NotEndsWithFold),
+	// named regex pattern
+	"pattern": reflect.ValueOf(Pattern),
 	// ---
 	"isStringNumber":   reflect.ValueOf(IsStringNumber),
 	"hasWhitespace":    reflect.ValueOf(HasWhitespace),
@@ -106,6 +248,11 @@ var validatorValues = map[string]reflect.Value{
 	"isFilePath": reflect.ValueOf(IsFilePath),
 	"isUnixPath": reflect.ValueOf(IsUnixPath),
 	"isWinPath":  reflect.ValueOf(IsWinPath),
+	"safePath":   reflect.ValueOf(SafePath),
+	"withinBase": reflect.ValueOf(WithinBase),
+	// file system, by the FileSystem
+	"pathReadable": reflect.ValueOf(PathReadable),
+	"pathWritable": reflect.ValueOf(PathWritable),
 	// date check
 	"isDate":     reflect.ValueOf(IsDate),
 	"afterDate":  reflect.ValueOf(AfterDate),
@@ -113,6 +260,26 @@ var validatorValues = map[string]reflect.Value{
 	// ---
 	"afterOrEqualDate":  reflect.ValueOf(AfterOrEqualDate),
 	"beforeOrEqualDate": reflect.ValueOf(BeforeOrEqualDate),
+	// strict date time format
+	"isISO8601":     reflect.ValueOf(IsISO8601),
+	"isRFC3339Nano": reflect.ValueOf(IsRFC3339Nano),
+	// unix timestamp
+	"isUnixTimestamp": reflect.ValueOf(IsUnixTimestamp),
+	"tsAfter":         reflect.ValueOf(TsAfter),
+	"tsBefore":        reflect.ValueOf(TsBefore),
+	// age
+	"minAge": reflect.ValueOf(MinAge),
+	"maxAge": reflect.ValueOf(MaxAge),
+	// business day
+	"isBusinessDay": reflect.ValueOf(IsBusinessDay),
+	"notHoliday":    reflect.ValueOf(NotHoliday),
+	// duration
+	"isDuration":  reflect.ValueOf(IsDuration),
+	"minDuration": reflect.ValueOf(MinDuration),
+	"maxDuration": reflect.ValueOf(MaxDuration),
+	// timezone
+	"isTimezone": reflect.ValueOf(IsTimezone),
+	"isCronExpr": reflect.ValueOf(IsCronExpr),
 }
 
 // define validator alias name mapping
@@ -121,6 +288,11 @@ var validatorAliases = map[string]string{
 	"in":     "enum",
 	"not_in": "notIn",
 	"range":  "between",
+	// registered enum sets. see RegisterEnum()
+	"notEnum":  "notIn",
+	"not_enum": "notIn",
+	// decimal
+	"decimal": "isDecimal",
 	// type
 	"int":       "isInt",
 	"integer":   "isInt",
@@ -201,6 +373,8 @@ var validatorAliases = map[string]string{
 	"end_with":        "endsWith",
 	"endswith":        "endsWith",
 	"ends_with":       "endsWith",
+	"not_starts_with": "notStartsWith",
+	"not_ends_with":   "notEndsWith",
 	// string
 	"ip":         "isIP",
 	"IP":         "isIP",
@@ -302,6 +476,196 @@ var validatorAliases = map[string]string{
 	"UUID5":      "isUUID5",
 	"cnMobile":   "isCnMobile",
 	"cn_mobile":  "isCnMobile",
+	// phone
+	"phone": "isPhone",
+	"e164":  "isE164",
+	"E164":  "isE164",
+	// postal code
+	"postal_code": "postalCode",
+	"zipCode":     "postalCode",
+	// ISO codes
+	"countryCode":   "isCountryCode",
+	"country_code":  "isCountryCode",
+	"currencyCode":  "isCurrencyCode",
+	"currency_code": "isCurrencyCode",
+	"languageTag":   "isLanguageTag",
+	"language_tag":  "isLanguageTag",
+	// finance
+	"creditCard":  "isCreditCard",
+	"credit_card": "isCreditCard",
+	"iban":        "isIBAN",
+	"IBAN":        "isIBAN",
+	"ibanCountry": "isIBAN",
+	"bic":         "isBIC",
+	"BIC":         "isBIC",
+	"swift":       "isBIC",
+	"vatNumber":   "isVATNumber",
+	"vat_number":  "isVATNumber",
+	// book and product codes
+	"issn":   "isISSN",
+	"ISSN":   "isISSN",
+	"ean8":   "isEAN8",
+	"EAN8":   "isEAN8",
+	"ean13":  "isEAN13",
+	"EAN13":  "isEAN13",
+	"gtin14": "isGTIN14",
+	"GTIN14": "isGTIN14",
+	// crypto currency
+	"btcAddress":     "isBTCAddress",
+	"btc_address":    "isBTCAddress",
+	"ethAddress":     "isETHAddress",
+	"eth_address":    "isETHAddress",
+	"cryptoAddress":  "isCryptoAddress",
+	"crypto_address": "isCryptoAddress",
+	// identity
+	"nationalID":  "isNationalID",
+	"national_id": "isNationalID",
+	// geo
+	"latLng":   "isLatLng",
+	"lat_lng":  "isLatLng",
+	"geoJSON":  "isGeoJSON",
+	"geojson":  "isGeoJSON",
+	"geo_json": "isGeoJSON",
+	// network
+	"ipInRange":   "isIPInRange",
+	"ip_in_range": "isIPInRange",
+	"port":        "isPort",
+	"portRange":   "isPortRange",
+	"port_range":  "isPortRange",
+	// hostname
+	"dnsLabel":         "isDNSLabel",
+	"dns_label":        "isDNSLabel",
+	"hostname":         "isHostname",
+	"hostnameRFC1123":  "isHostname",
+	"hostname_rfc1123": "isHostname",
+	"fqdn":             "isFQDN",
+	"FQDN":             "isFQDN",
+	// DNS lookup
+	"domainResolvable":  "isDomainResolvable",
+	"domain_resolvable": "isDomainResolvable",
+	"emailMX":           "isEmailMX",
+	"email_mx":          "isEmailMX",
+	// disposable email
+	"emailNotDisposable":   "isEmailNotDisposable",
+	"email_not_disposable": "isEmailNotDisposable",
+	// sortable ID
+	"ulid":  "isULID",
+	"ULID":  "isULID",
+	"ksuid": "isKSUID",
+	"KSUID": "isKSUID",
+	"xid":   "isXID",
+	"XID":   "isXID",
+	// snowflake ID
+	"snowflake": "isSnowflake",
+	// encoding
+	"base32":       "isBase32",
+	"base58":       "isBase58",
+	"hexPrefixed":  "isHexPrefixed",
+	"hex_prefixed": "isHexPrefixed",
+	// password hash
+	"bcryptHash":  "isBcryptHash",
+	"bcrypt_hash": "isBcryptHash",
+	"argon2Hash":  "isArgon2Hash",
+	"argon2_hash": "isArgon2Hash",
+	"pbkdf2Hash":  "isPBKDF2Hash",
+	"pbkdf2_hash": "isPBKDF2Hash",
+	// PEM
+	"pemCert":  "isPEMCert",
+	"pem_cert": "isPEMCert",
+	"pemKey":   "isPEMKey",
+	"pem_key":  "isPEMKey",
+	"pemCSR":   "isPEMCSR",
+	"pem_csr":  "isPEMCSR",
+	// SSH
+	"sshPublicKey":   "isSSHPublicKey",
+	"ssh_public_key": "isSSHPublicKey",
+	// version
+	"semver":       "isSemver",
+	"semverRange":  "isSemverRange",
+	"semver_range": "isSemverRange",
+	// container image
+	"ociImageRef":   "isOCIImageRef",
+	"oci_image_ref": "isOCIImageRef",
+	"imageRef":      "isOCIImageRef",
+	"image_ref":     "isOCIImageRef",
+	// kubernetes
+	"k8sName":            "isK8sName",
+	"k8s_name":           "isK8sName",
+	"k8sLabelValue":      "isK8sLabelValue",
+	"k8s_label_value":    "isK8sLabelValue",
+	"k8sQualifiedName":   "isK8sQualifiedName",
+	"k8s_qualified_name": "isK8sQualifiedName",
+	// AWS
+	"awsArn":    "isAWSARN",
+	"awsARN":    "isAWSARN",
+	"aws_arn":   "isAWSARN",
+	"s3Bucket":  "isS3Bucket",
+	"s3_bucket": "isS3Bucket",
+	// git
+	"gitSHA":          "isGitSHA",
+	"git_sha":         "isGitSHA",
+	"gitBranchName":   "isGitBranchName",
+	"git_branch_name": "isGitBranchName",
+	"gitRefName":      "isGitRefName",
+	"git_ref_name":    "isGitRefName",
+	// slug
+	"slug": "isSlug",
+	// username
+	"username": "isUsername",
+	// password
+	"password":          "isPassword",
+	"password_strength": "passwordStrength",
+	"not_pwned":         "notPwned",
+	// one-time code
+	"otpCode":         "isOTPCode",
+	"otp_code":        "isOTPCode",
+	"backupCode":      "isBackupCode",
+	"backup_code":     "isBackupCode",
+	"secure_eq_field": "secureEqField",
+	// banned words
+	"cleanText":  "isCleanText",
+	"clean_text": "isCleanText",
+	// dangerous content
+	"no_html_tags":   "noHTMLTags",
+	"no_script":      "noScript",
+	"safe_rich_text": "safeRichText",
+	// admin tooling
+	"sqlIdentifier":  "isSQLIdentifier",
+	"sql_identifier": "isSQLIdentifier",
+	"ldapDN":         "isLDAPDN",
+	"ldap_dn":        "isLDAPDN",
+	// process input
+	"shell_safe":   "shellSafe",
+	"envVarName":   "isEnvVarName",
+	"env_var_name": "isEnvVarName",
+	// config text
+	"xml_well_formed": "xmlWellFormed",
+	"yaml_parsable":   "yamlParsable",
+	// unicode normalization
+	"is_nfc": "isNFC",
+	// byte length
+	"byte_len":     "byteLen",
+	"min_byte_len": "minByteLen",
+	"max_byte_len": "maxByteLen",
+	// homoglyph
+	"no_confusables": "noConfusables",
+	"single_script":  "singleScript",
+	// emoji
+	"no_emoji":  "noEmoji",
+	"has_emoji": "hasEmoji",
+	// slice elements
+	"unique":    "distinct",
+	"unique_by": "uniqueBy",
+	// slice set
+	"subset_of":   "subsetOf",
+	"superset_of": "supersetOf",
+	// items count
+	"min_items":     "minItems",
+	"max_items":     "maxItems",
+	"items_between": "itemsBetween",
+	// contains items
+	"contains_all": "containsAll",
+	"contains_any": "containsAny",
 	// file system
 	"path_exists": "pathExists",
 	"pathExist":   "pathExists",
@@ -315,6 +679,15 @@ var validatorAliases = map[string]string{
 	"unix_path":   "isUnixPath",
 	"winPath":     "isWinPath",
 	"win_path":    "isWinPath",
+	"safe_path":   "safePath",
+	"within_base": "withinBase",
+	// file system, by the FileSystem
+	"fileExists":    "isFilePath",
+	"file_exists":   "isFilePath",
+	"dirExists":     "isDirPath",
+	"dir_exists":    "isDirPath",
+	"path_readable": "pathReadable",
+	"path_writable": "pathWritable",
 	// date
 	"date":     "isDate",
 	"gtDate":   "afterDate",
@@ -325,6 +698,31 @@ var validatorAliases = map[string]string{
 	"gte_date": "afterOrEqualDate",
 	"lteDate":  "beforeOrEqualDate",
 	"lte_date": "beforeOrEqualDate",
+	// strict date time format
+	"iso8601":     "isISO8601",
+	"rfc3339nano": "isRFC3339Nano",
+	// unix timestamp
+	"unixTimestamp":  "isUnixTimestamp",
+	"unix_timestamp": "isUnixTimestamp",
+	"ts_after":       "tsAfter",
+	"ts_before":      "tsBefore",
+	// age
+	"min_age": "minAge",
+	"max_age": "maxAge",
+	// business day
+	"businessDay":  "isBusinessDay",
+	"business_day": "isBusinessDay",
+	"not_holiday":  "notHoliday",
+	// duration
+	"duration":     "isDuration",
+	"min_duration": "minDuration",
+	"max_duration": "maxDuration",
+	// timezone
+	"timezone": "isTimezone",
+	"tz":       "isTimezone",
+	// cron
+	"cronExpr":  "isCronExpr",
+	"cron_expr": "isCronExpr",
 	// uploaded file
 	"img":          "isImage",
 	"image":        "isImage",
@@ -344,6 +742,11 @@ var validatorAliases = map[string]string{
 	"gte_field": "gteField",
 	"lt_field":  "ltField",
 	"lte_field": "lteField",
+	// field date compare
+	"after_field":           "afterField",
+	"after_or_equal_field":  "afterOrEqualField",
+	"before_field":          "beforeField",
+	"before_or_equal_field": "beforeOrEqualField",
 	// requiredXXX
 	"required_if":          "requiredIf",
 	"required_unless":      "requiredUnless",
@@ -0,0 +1,49 @@
+package validate
+
+import (
+	"context"
+	"net"
+	"strings"
+)
+
+// Resolver definition. use for the DNS lookup rules: domainResolvable, emailMX
+//
+// The std *net.Resolver is implemented it. inject a custom resolver to add caching,
+// timeouts, or stub it in tests.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// resolver for the DNS lookup rules. default is net.DefaultResolver
+var resolver Resolver = net.DefaultResolver
+
+// SetResolver set the resolver for the DNS lookup rules. set nil will reset to net.DefaultResolver
+//
+// Usage:
+// 	validate.SetResolver(&net.Resolver{PreferGo: true})
+func SetResolver(r Resolver) {
+	if r == nil {
+		r = net.DefaultResolver
+	}
+	resolver = r
+}
+
+// check the domain has A/AAAA records. the ctx is the validation context, see Validation.Context()
+func lookupHost(ctx context.Context, domain string) bool {
+	addrs, err := resolver.LookupHost(ctx, domain)
+	return err == nil && len(addrs) > 0
+}
+
+// check the domain has MX records. the null MX(RFC 7505) means the domain does not accept email.
+func lookupMX(ctx context.Context, domain string) bool {
+	records, err := resolver.LookupMX(ctx, domain)
+	if err != nil || len(records) == 0 {
+		return false
+	}
+
+	if len(records) == 1 && strings.TrimSuffix(records[0].Host, ".") == "" {
+		return false
+	}
+	return true
+}
@@ -21,6 +21,10 @@ type Rule struct {
 	optional bool
 	// skip validate not exist field/empty value
 	skipEmpty bool
+	// is soft constraint, failure will be collected as warning.
+	warn bool
+	// reverse the validator result. eg: "not:in:admin,root"
+	negate bool
 	// default value setting
 	defValue interface{}
 	// error message
@@ -39,13 +43,23 @@ type Rule struct {
 	nameNotRequired bool
 	// arguments for the validator
 	arguments []interface{}
+	// the registered enum set name of the "enum", "notEnum" rules. see RegisterEnum()
+	enumName string
 	// --- some hooks function
 	// has beforeFunc. if return false, skip validate current rule
 	beforeFunc func(v *Validation) bool // func (val interface{}) bool
+	// the "when" expressions, skip validate current rule on any of them is false
+	whens []*whenExpr
+	// the alternative rule groups, the value must pass any of them. eg: "or:(email)(phone:US)"
+	groups [][]*Rule
+	// is the rule in a rule group. the value type mismatch is a failure, not an error
+	grouped bool
 	// you can custom filter func
 	filterFunc func(val interface{}) (interface{}, error)
 	// custom check function's mate info
 	checkFuncMeta *funcMeta
+	// the length mode for the length validators. see SetLengthMode()
+	lenMode string
 	// custom check is empty. TODO
 	// emptyChecker func(val interface{}) bool
 }
@@ -54,9 +68,10 @@ type Rule struct {
 func NewRule(fields, validator string, args ...interface{}) *Rule {
 	return &Rule{
 		fields: stringSplit(fields, ","),
-		// validator args
-		arguments: args,
+		// validator args. the field references are parsed, see fieldRef
+		arguments: parseFieldRefs(args),
 		validator: validator,
+		enumName:  enumSetName(validator, args),
 	}
 }
 
@@ -76,6 +91,55 @@ func (r *Rule) SetSkipEmpty(skipEmpty bool) {
 	r.skipEmpty = skipEmpty
 }
 
+// SetWarning mark the rule is soft constraint, failure will be collected
+// to Validation.Warnings instead of Validation.Errors.
+//
+// Usage:
+// 	v.AddRule("password", "minLen", 10).SetWarning(true)
+func (r *Rule) SetWarning(warn bool) *Rule {
+	r.warn = warn
+	return r
+}
+
+// SetNegate reverse the validator result, the rule is failed on the validator passed.
+// the validator name is prefixed with "not:" for the error key and message. eg: "not:in"
+//
+// Usage:
+// 	v.AddRule("role", "in", []string{"admin", "root"}).SetNegate(true)
+func (r *Rule) SetNegate(negate bool) *Rule {
+	if negate == r.negate {
+		return r
+	}
+
+	if negate {
+		if !isNegatable(r.realName) {
+			configErrorf("the validator '%s' can not be negated", r.validator)
+			return r
+		}
+		r.validator = negatePrefix + r.validator
+	} else {
+		r.validator = r.validator[len(negatePrefix):]
+	}
+
+	r.negate = negate
+	return r
+}
+
+// SetLengthMode set how to measure the string length for the length validators.
+// allow: LengthModeRune, LengthModeGrapheme
+//
+// Usage:
+// 	v.AddRule("nickname", "maxLen", 10).SetLengthMode(validate.LengthModeGrapheme)
+func (r *Rule) SetLengthMode(mode string) *Rule {
+	if !isLengthMode(mode) {
+		configErrorf("invalid length mode '%s', allow: rune, grapheme", mode)
+		return r
+	}
+
+	r.lenMode = mode
+	return r
+}
+
 // SetDefValue for the rule
 // func (r *Rule) SetDefValue(defValue interface{}) {
 // 	r.defValue = defValue
@@ -90,8 +154,9 @@ func (r *Rule) SetCheckFunc(checkFunc interface{}) *Rule {
 		name = "rule_" + strings.Join(r.fields, "_")
 	}
 
-	fv := checkValidatorFunc(name, checkFunc)
-	r.checkFuncMeta = newFuncMeta(name, false, fv)
+	if fv := checkValidatorFunc(name, checkFunc); fv.IsValid() {
+		r.checkFuncMeta = newFuncMeta(name, false, fv)
+	}
 	return r
 }
 
@@ -106,6 +171,17 @@ func (r *Rule) SetBeforeFunc(fn func(v *Validation) bool) {
 	r.beforeFunc = fn
 }
 
+// SetWhen add the "when" expression for the rule. skip validate on the expression is false.
+//
+// Usage:
+// 	v.AddRule("taxId", "required").SetWhen("type=='corp' && country in ['US','CA']")
+func (r *Rule) SetWhen(expr string) *Rule {
+	if e := compileWhen(expr); e != nil {
+		r.whens = append(r.whens, e)
+	}
+	return r
+}
+
 // SetMessage set error message.
 //
 // Usage:
@@ -132,7 +208,15 @@ func (r *Rule) Fields() []string {
 	return r.fields
 }
 
-func (r *Rule) errorMessage(field, validator string, v *Validation) (msg string) {
+// build error message for the field, val is the field value.
+func (r *Rule) errorMessage(field, validator string, val interface{}, v *Validation) string {
+	msg := r.findMessage(field, validator, v)
+	// replace the extra message params. eg: "{cronField}"
+	args, _ := r.resolveArgs(v)
+	return applyMessageParams(msg, r.realName, val, args)
+}
+
+func (r *Rule) findMessage(field, validator string, v *Validation) (msg string) {
 	if r.messages != nil {
 		var ok bool
 		// use full key. "field.validator"
@@ -151,27 +235,62 @@ func (r *Rule) errorMessage(field, validator string, v *Validation) (msg string)
 	}
 
 	// built in error messages
-	return v.trans.Message(validator, field, r.arguments...)
+	args, _ := r.resolveArgs(v)
+	return v.trans.Message(validator, field, args...)
 }
 
 /*************************************************************
  * add validate rules
  *************************************************************/
 
+const (
+	// the rule prefix for mark it as soft constraint.
+	warnPrefix = "warn:"
+	// the rule prefix for reverse the validator result.
+	negatePrefix = "not:"
+)
+
+// check the validator result can be reversed. the "requiredXXX" and
+// file validators are not allowed, they have the special empty value handling.
+func isNegatable(realName string) bool {
+	return !strings.HasPrefix(realName, "required") && !isFileValidator(realName) &&
+		realName != "-" && realName != "safe"
+}
+
 // StringRule add field rules by string
 //
 // Usage:
 // 	v.StringRule("name", "required|string|minLen:6")
 // 	// will try convert to int before apply validate.
 // 	v.StringRule("age", "required|int|min:12", "toInt")
+// 	// soft constraint, failure will be collected as warning.
+// 	v.StringRule("password", "required|warn:minLen:10")
+// 	// reverse the validator result.
+// 	v.StringRule("username", "required|not:in:admin,root|not:regexp:^\\d+$")
+// 	// the rules after the "when" are validated on the expression is true.
+// 	v.StringRule("taxId", `when:"type=='corp'"|required|len:9`)
 func (v *Validation) StringRule(field, rule string, filterRule ...string) *Validation {
 	rule = strings.TrimSpace(rule)
 	if rule == "" {
 		return v
 	}
 
-	rules := stringSplit(strings.Trim(rule, "|:"), "|")
+	// the "when" expressions for the rest rules
+	var whens []*whenExpr
+	rules := splitRule(strings.Trim(rule, "|:"), '|')
 	for _, validator := range rules {
+		var r *Rule
+		// is soft constraint. eg: "warn:minLen:10"
+		warn := strings.HasPrefix(validator, warnPrefix)
+		if warn {
+			validator = validator[len(warnPrefix):]
+		}
+		// reverse the validator result. eg: "not:in:admin,root"
+		negate := strings.HasPrefix(validator, negatePrefix)
+		if negate {
+			validator = validator[len(negatePrefix):]
+		}
+
 		validator = strings.Trim(validator, ":")
 		if validator == "" { // empty
 			continue
@@ -179,26 +298,56 @@ func (v *Validation) StringRule(field, rule string, filterRule ...string) *Valid
 
 		// has args "min:12"
 		if strings.ContainsRune(validator, ':') {
-			list := stringSplit(validator, ":")
 			// reassign value
-			validator := list[0]
+			validator, argStr := splitRuleArgs(validator)
 			realName := ValidatorName(validator)
 			switch realName {
 			// add default value for the field
 			case "default":
-				v.SetDefValue(field, list[1])
+				v.SetDefValue(field, unquoteArg(argStr))
+			// set the length mode for the field. eg: "lenMode:grapheme"
+			case "lenMode":
+				v.SetLengthMode(field, argStr)
+			// gate the rest rules. eg: `when:"type=='corp'"`
+			case "when":
+				if e := compileWhen(unquoteArg(argStr)); e != nil {
+					whens = append(whens, e)
+				}
 			// eg 'regex:\d{4,6}' dont need split args. args is "\d{4,6}"
+			// the pattern can be quoted. eg: 'regex:"^a{2,4}$"'
 			case "regexp":
-				v.AddRule(field, validator, list[1])
+				r = v.AddRule(field, validator, unquoteArg(argStr))
+			// the value must pass any of the rule groups. eg: "or:(email)(phone:US)"
+			case orRuleName:
+				r = v.addRuleGroups(field, validator, argStr)
 			// some special validator. need merge args to one.
 			case "enum", "notIn":
-				v.AddRule(field, validator, parseArgString(list[1]))
+				r = v.AddRule(field, validator, parseArgString(argStr))
+			// the case-insensitive flag. eg: "startsWith:img_,pic_:i"
+			case "startsWith", "endsWith", "notStartsWith", "notEndsWith":
+				realName, argStr = foldAffixRule(realName, argStr)
+				r = v.addOneRule(field, validator, realName, parseRuleArgs(argStr))
 			default:
-				args := parseArgString(list[1])
-				v.AddRule(field, validator, strings2Args(args)...)
+				r = v.AddRule(field, validator, parseRuleArgs(argStr)...)
 			}
 		} else {
-			v.AddRule(field, validator)
+			r = v.AddRule(field, validator)
+		}
+
+		if r == nil {
+			if negate {
+				configErrorf("the rule '%s' can not be negated", validator)
+			}
+			continue
+		}
+		if warn {
+			r.warn = true
+		}
+		if negate {
+			r.SetNegate(true)
+		}
+		if len(whens) > 0 {
+			r.whens = append(r.whens, whens...)
 		}
 	}
 
@@ -236,11 +385,26 @@ func (v *Validation) ConfigRules(mp MS) *Validation {
 	return v
 }
 
-// AddRule for current validation
+// AddRule for current validation. the validator can be prefixed with "not:" to reverse the result.
 func (v *Validation) AddRule(fields, validator string, args ...interface{}) *Rule {
+	if strings.HasPrefix(validator, negatePrefix) {
+		return v.AddRule(fields, validator[len(negatePrefix):], args...).SetNegate(true)
+	}
 	return v.addOneRule(fields, validator, ValidatorName(validator), args)
 }
 
+// add the rule groups for current validation. the group rule strings are the rule arguments.
+func (v *Validation) addRuleGroups(field, validator, argStr string) *Rule {
+	groups := parseRuleGroups(field, argStr)
+	if groups == nil {
+		return nil
+	}
+
+	r := v.addOneRule(field, validator, orRuleName, strings2Args(splitRuleGroups(argStr)))
+	r.groups = groups
+	return r
+}
+
 // add one Rule for current validation
 func (v *Validation) addOneRule(fields, validator, realName string, args []interface{}) *Rule {
 	rule := NewRule(fields, validator, args...)
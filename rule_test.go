@@ -88,7 +88,7 @@ func TestRule_SetFilterFunc(t *testing.T) {
 		})
 
 	is.False(v.Validate())
-	is.Equal(`strconv.Atoi: parsing "abc": invalid syntax`, v.Errors.One())
+	is.Contains(v.Errors.One(), `strconv.Atoi: parsing "abc": invalid syntax`)
 }
 
 func TestRule_SetSkipEmpty(t *testing.T) {
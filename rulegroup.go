@@ -0,0 +1,147 @@
+package validate
+
+import (
+	"strings"
+
+	"github.com/gookit/goutil/strutil"
+)
+
+// the rule name of the alternative rule groups. eg: "or:(email)(phone:US)"
+const orRuleName = "or"
+
+// check the rule item is the rule groups. eg: "or:(email)", "warn:or:(email)"
+func isRuleGroups(item string) bool {
+	item = strings.TrimPrefix(strings.TrimSpace(item), warnPrefix)
+	item = strings.TrimPrefix(item, negatePrefix)
+	return strings.HasPrefix(item, orRuleName+":")
+}
+
+// split the rule groups string to the group rule strings. eg: "(email)(string|minLen:3)" -> ["email", "string|minLen:3"]
+// the parentheses in the double quotes are ignored, the parentheses in the group must be balanced.
+func splitRuleGroups(argStr string) []string {
+	var groups []string
+	var quoted bool
+	var start, depth int
+	for i := 0; i < len(argStr); i++ {
+		switch {
+		case isRuleEscape(argStr, i):
+			i++
+		case argStr[i] == '"':
+			quoted = !quoted
+		case quoted:
+		case argStr[i] == '(':
+			if depth == 0 {
+				start = i + 1
+			}
+			depth++
+		case argStr[i] == ')':
+			if depth == 0 {
+				return nil
+			}
+			if depth--; depth == 0 {
+				groups = append(groups, strings.TrimSpace(argStr[start:i]))
+			}
+		case depth == 0 && argStr[i] != ' ':
+			return nil
+		}
+	}
+
+	if quoted || depth > 0 {
+		return nil
+	}
+	return groups
+}
+
+// parse the rule groups for the field. eg: "(email)(phone:US)"
+func parseRuleGroups(field, argStr string) [][]*Rule {
+	groups := splitRuleGroups(argStr)
+	if len(groups) == 0 {
+		configErrorf("invalid rule groups '%s', expect: (rule1|rule2)(rule3)", argStr)
+		return nil
+	}
+
+	ruleGroups := make([][]*Rule, 0, len(groups))
+	for _, group := range groups {
+		var rules []*Rule
+		for _, item := range splitRule(group, '|') {
+			if r := buildGroupRule(field, item); r != nil {
+				rules = append(rules, r)
+			}
+		}
+
+		if len(rules) == 0 {
+			configErrorf("the rule group '(%s)' is empty", group)
+			return nil
+		}
+		ruleGroups = append(ruleGroups, rules)
+	}
+	return ruleGroups
+}
+
+// build the rule of the rule group. the rule is not added to the validation.
+func buildGroupRule(field, item string) *Rule {
+	// reverse the validator result. eg: "not:in:admin,root"
+	negate := strings.HasPrefix(item, negatePrefix)
+	if negate {
+		item = item[len(negatePrefix):]
+	}
+
+	validator, argStr := splitRuleArgs(strings.Trim(item, ":"))
+	realName := ValidatorName(validator)
+
+	var args []interface{}
+	switch realName {
+	case "default", "lenMode", "when", orRuleName:
+		configErrorf("the rule '%s' is not allowed in the rule group", validator)
+		return nil
+	case "regexp":
+		args = []interface{}{unquoteArg(argStr)}
+	case "enum", "notIn":
+		args = []interface{}{parseArgString(argStr)}
+	case "startsWith", "endsWith", "notStartsWith", "notEndsWith":
+		realName, argStr = foldAffixRule(realName, argStr)
+		args = parseRuleArgs(argStr)
+	default:
+		if argStr != "" {
+			args = parseRuleArgs(argStr)
+		}
+	}
+
+	r := buildRule(field, validator, realName, args)
+	r.grouped = true
+	if negate {
+		r.SetNegate(true)
+	}
+	return r
+}
+
+// validate the value by the rule groups, it is passed on all rules of any group are passed.
+func (r *Rule) groupsValidate(field string, val interface{}, v *Validation) bool {
+	for _, rules := range r.groups {
+		passed := true
+		for _, gr := range rules {
+			if gr.lenMode == "" {
+				gr.lenMode = r.lenMode
+			}
+			// the missing referenced field fails the group
+			if ok, _ := gr.valueValidate(field, gr.realName, val, v); !ok {
+				passed = false
+				break
+			}
+		}
+
+		if passed {
+			return true
+		}
+	}
+	return false
+}
+
+// the extra message params for the rule groups. {groups}: eg: "(email) or (phone:US)"
+func groupsMessageParams(_ interface{}, args []interface{}) map[string]string {
+	groups := make([]string, len(args))
+	for i, arg := range args {
+		groups[i] = "(" + strutil.MustString(arg) + ")"
+	}
+	return map[string]string{"groups": strings.Join(groups, " or ")}
+}
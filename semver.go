@@ -0,0 +1,98 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+)
+
+// the semantic version 2.0.0. see https://semver.org
+var rxSemver = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// the operators of the version comparator, the longer is in front.
+var semverOps = []string{">=", "<=", ">", "<", "=", "~", "^"}
+
+// check the version range expression. eg: ">=1.2.0 <2.0.0", "^1.2 || ~2.0.1", "1.2.0 - 1.4.x"
+//
+// 	range-set  = range ( "||" range )*
+// 	range      = hyphen | simple ( " " simple )*
+// 	simple     = ( op )? partial
+// 	partial    = xr ( "." xr ( "." xr qualifier? )? )?, the xr is "x", "X", "*" or number.
+func checkSemverRange(s string) bool {
+	if strings.TrimSpace(s) == "" {
+		return false
+	}
+
+	for _, set := range strings.Split(s, "||") {
+		if !checkSemverComparators(strings.Fields(set)) {
+			return false
+		}
+	}
+	return true
+}
+
+func checkSemverComparators(fields []string) bool {
+	if len(fields) == 0 {
+		return false
+	}
+
+	// hyphen range. eg: "1.2.0 - 1.4.x"
+	if len(fields) == 3 && fields[1] == "-" {
+		return isSemverPartial(fields[0]) && isSemverPartial(fields[2])
+	}
+
+	for i := 0; i < len(fields); i++ {
+		ver := fields[i]
+		for _, op := range semverOps {
+			if strings.HasPrefix(ver, op) {
+				ver = ver[len(op):]
+				break
+			}
+		}
+
+		// allow the space after the operator. eg: ">= 1.2.0"
+		if ver == "" && i+1 < len(fields) {
+			i++
+			ver = fields[i]
+		}
+
+		if !isSemverPartial(ver) {
+			return false
+		}
+	}
+	return true
+}
+
+// check the partial version, the "v" prefix is allowed. eg: "1", "1.2", "1.x", "v1.2.3-beta.1"
+// the pre-release and build metadata are only allowed on the full version.
+func isSemverPartial(s string) bool {
+	s = strings.TrimPrefix(s, "v")
+	core := s
+	if pos := strings.IndexAny(s, "-+"); pos >= 0 {
+		core = s[:pos]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return false
+	}
+
+	for _, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			if core != s {
+				return false
+			}
+			continue
+		}
+
+		if !isDigits(part) || len(part) > 1 && part[0] == '0' {
+			return false
+		}
+	}
+
+	if core != s {
+		return len(parts) == 3 && rxSemver.MatchString(s)
+	}
+	return true
+}
@@ -0,0 +1,12 @@
+package validate
+
+import "regexp"
+
+// the chars never need the quoting in the POSIX shell. same as the python shlex.quote()
+var rxShellSafe = regexp.MustCompile(`^[a-zA-Z0-9_@%+=:,./-]+$`)
+
+// the environment variable name. see POSIX "Environment Variables"
+var (
+	rxEnvVarName      = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	rxUpperEnvVarName = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+)
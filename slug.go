@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// the slug: lower case letters and digits, separated by single hyphen.
+var rxSlug = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// the transliteration of the letters that cannot be decomposed to ASCII by NFKD.
+var slugTransliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i",
+	// russian
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// Slugify filter, convert the title to the URL-safe slug. eg: "Hello, Wörld!" -> "hello-world"
+// the accents are removed and some letters are transliterated, the other non-ASCII chars are dropped.
+//
+// Usage:
+// 	v.FilterRule("slug", "slugify")
+func Slugify(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	// the pending hyphen between the words
+	var sep bool
+	for _, r := range norm.NFKD.String(strings.ToLower(s)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		var part string
+		if tr, ok := slugTransliterations[r]; ok {
+			part = tr
+		} else if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			part = string(r)
+		} else {
+			sep = sb.Len() > 0
+			continue
+		}
+
+		if part == "" {
+			continue
+		}
+		if sep {
+			sb.WriteByte('-')
+			sep = false
+		}
+		sb.WriteString(part)
+	}
+	return sb.String()
+}
@@ -0,0 +1,164 @@
+package validate
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// the identifier rules of the SQL dialect.
+type sqlDialect struct {
+	// the max length of the identifier, 0 is no limit.
+	maxLen int
+	// the extra chars allowed in the unquoted identifier besides the letters, digits and "_"
+	extraChars string
+	// the unquoted identifier can start with a digit, but cannot be all digits.
+	digitStart bool
+	// the quote pairs of the quoted identifier. the close quote is escaped by doubling it.
+	quotes []string
+}
+
+// the supported SQL dialects. the default is "ansi"
+var sqlDialects = map[string]sqlDialect{
+	"ansi":     {maxLen: 128, quotes: []string{`""`}},
+	"mysql":    {maxLen: 64, extraChars: "$", digitStart: true, quotes: []string{"``", `""`}},
+	"postgres": {maxLen: 63, extraChars: "$", quotes: []string{`""`}},
+	"sqlite":   {quotes: []string{`""`, "``", "[]"}},
+	"mssql":    {maxLen: 128, extraChars: "@$#", quotes: []string{"[]", `""`}},
+}
+
+// the common reserved words of the SQL standard and the popular databases, they must be quoted.
+const sqlReservedTable = `
+add all alter and any as asc between by case check column constraint create cross
+database default delete desc distinct drop else end exists foreign from full grant
+group having in index inner insert intersect into is join key left like limit not
+null on or order outer primary references revoke right select set table then to
+union unique update user using values view when where with
+`
+
+var sqlReservedWords = make(map[string]bool)
+
+func init() {
+	for _, word := range strings.Fields(sqlReservedTable) {
+		sqlReservedWords[word] = true
+	}
+}
+
+// check the SQL identifier by the dialect. the qualified name is split by "." eg: "schema.table"
+func checkSQLIdentifier(s string, d sqlDialect, qualified bool) bool {
+	parts := []string{s}
+	if qualified {
+		var ok bool
+		if parts, ok = splitSQLQualified(s, d); !ok || len(parts) > 3 {
+			return false
+		}
+	}
+
+	for _, part := range parts {
+		if !checkSQLName(part, d) {
+			return false
+		}
+	}
+	return true
+}
+
+// split the qualified name by the "." outside the quotes.
+func splitSQLQualified(s string, d sqlDialect) ([]string, bool) {
+	var parts []string
+	for {
+		end := -1
+		if q := sqlQuoteOf(s, d); q != "" {
+			// find the close quote, the doubled close quote is escaped.
+			for i := 1; i < len(s); i++ {
+				if s[i] != q[1] {
+					continue
+				}
+				if i+1 < len(s) && s[i+1] == q[1] {
+					i++
+					continue
+				}
+				end = i + 1
+				break
+			}
+			if end < 0 {
+				return nil, false
+			}
+		} else if end = strings.IndexByte(s, '.'); end < 0 {
+			end = len(s)
+		}
+
+		parts = append(parts, s[:end])
+		if end == len(s) {
+			return parts, true
+		}
+		if s[end] != '.' {
+			return nil, false
+		}
+		s = s[end+1:]
+	}
+}
+
+// get the quote pair of the quoted identifier.
+func sqlQuoteOf(s string, d sqlDialect) string {
+	for _, q := range d.quotes {
+		if s != "" && s[0] == q[0] {
+			return q
+		}
+	}
+	return ""
+}
+
+// check the unqualified identifier, quoted or unquoted.
+func checkSQLName(s string, d sqlDialect) bool {
+	if s == "" {
+		return false
+	}
+
+	if q := sqlQuoteOf(s, d); q != "" {
+		if len(s) < 3 || s[len(s)-1] != q[1] {
+			return false
+		}
+
+		// the close quote in the name must be doubled
+		name := s[1 : len(s)-1]
+		for i := 0; i < len(name); i++ {
+			if name[i] == 0 {
+				return false
+			}
+			if name[i] == q[1] {
+				if i+1 >= len(name) || name[i+1] != q[1] {
+					return false
+				}
+				i++
+			}
+		}
+
+		name = strings.Replace(name, q[1:]+q[1:], q[1:], -1)
+		return d.maxLen == 0 || utf8.RuneCountInString(name) <= d.maxLen
+	}
+
+	if d.maxLen > 0 && len(s) > d.maxLen || sqlReservedWords[strings.ToLower(s)] {
+		return false
+	}
+
+	allDigits := true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			if i == 0 && !d.digitStart {
+				return false
+			}
+		case isASCIILetter(c) || c == '_':
+			allDigits = false
+		case strings.IndexByte(d.extraChars, c) >= 0:
+			// the mssql identifier can start with "@" or "#", the others cannot start with "$"
+			if i == 0 && c == '$' {
+				return false
+			}
+			allDigits = false
+		default:
+			return false
+		}
+	}
+	return !allDigits
+}
@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"strings"
+
+	"github.com/gookit/goutil/arrutil"
+	"golang.org/x/crypto/ssh"
+)
+
+// the short names of the SSH public key types. see IsSSHPublicKey()
+var sshKeyTypes = map[string][]string{
+	"rsa":     {ssh.KeyAlgoRSA},
+	"dsa":     {ssh.KeyAlgoDSA},
+	"ecdsa":   {ssh.KeyAlgoECDSA256, ssh.KeyAlgoECDSA384, ssh.KeyAlgoECDSA521},
+	"ed25519": {ssh.KeyAlgoED25519},
+	// the security key types
+	"sk-ecdsa":   {ssh.KeyAlgoSKECDSA256},
+	"sk-ed25519": {ssh.KeyAlgoSKED25519},
+}
+
+// check the SSH public key in the authorized_keys format: "<type> <base64 blob> [comment]"
+// the key options prefix is not allowed. the key types can be the short name or the full type.
+func checkSSHPublicKey(s string, keyTypes []string) bool {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.ContainsAny(s, "\r\n") {
+		return false
+	}
+
+	key, _, options, rest, err := ssh.ParseAuthorizedKey([]byte(s))
+	if err != nil || len(options) > 0 || len(rest) > 0 {
+		return false
+	}
+
+	// the declared type must be same as the type in the blob
+	typ := key.Type()
+	if !strings.HasPrefix(s, typ+" ") {
+		return false
+	}
+
+	if len(keyTypes) == 0 {
+		return true
+	}
+
+	for _, name := range keyTypes {
+		name = strings.TrimSpace(name)
+		if name == typ {
+			return true
+		}
+
+		types, ok := sshKeyTypes[name]
+		if !ok && !strings.Contains(name, "-") {
+			configErrorf("invalid SSH key type '%s', allow: rsa, dsa, ecdsa, ed25519, sk-ecdsa, sk-ed25519 or the full type", name)
+			return false
+		}
+
+		if arrutil.StringsHas(types, typ) {
+			return true
+		}
+	}
+	return false
+}
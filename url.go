@@ -0,0 +1,151 @@
+package validate
+
+import (
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// the options of the url rule. see IsURL()
+type urlOptions struct {
+	schemes     []string
+	requireTLD  bool
+	noUserInfo  bool
+	noPrivateIP bool
+	idn         bool
+}
+
+// parse the url rule options. the "schemes(https,wss)" may be split by the comma, will join them.
+func parseURLOptions(options []string) (opt urlOptions, ok bool) {
+	for i := 0; i < len(options); i++ {
+		name := strings.TrimSpace(options[i])
+		switch name {
+		case "":
+		case "requireTLD":
+			opt.requireTLD = true
+		case "noUserInfo":
+			opt.noUserInfo = true
+		case "noPrivateIP":
+			opt.noPrivateIP = true
+		case "idn":
+			opt.idn = true
+		default:
+			if strings.HasPrefix(name, "schemes(") {
+				for !strings.HasSuffix(name, ")") && i+1 < len(options) {
+					i++
+					name += "," + strings.TrimSpace(options[i])
+				}
+				if !strings.HasSuffix(name, ")") {
+					configErrorf("invalid url option '%s', missing the ')'", name)
+					return opt, false
+				}
+
+				opt.schemes = append(opt.schemes, stringSplit(name[len("schemes("):len(name)-1], ",")...)
+				continue
+			}
+
+			if !isURLScheme(name) {
+				configErrorf("invalid url option '%s', allow: <scheme>, schemes(...), requireTLD, noUserInfo, noPrivateIP, idn", name)
+				return opt, false
+			}
+			opt.schemes = append(opt.schemes, name)
+		}
+	}
+	return opt, true
+}
+
+// scheme = ALPHA *( ALPHA / DIGIT / "+" / "-" / "." )
+func isURLScheme(s string) bool {
+	if s == "" || !isAlpha(s[:1]) {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		if c := s[i]; !isAlpha(s[i:i+1]) && !(c >= '0' && c <= '9') && c != '+' && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// check the absolute URL by the options.
+func checkURL(u *url.URL, opt urlOptions) bool {
+	host := u.Hostname()
+	if u.Scheme == "" || host == "" {
+		return false
+	}
+
+	if opt.idn {
+		var ok bool
+		if host, ok = domainToASCII(host); !ok {
+			return false
+		}
+	}
+
+	if len(opt.schemes) > 0 {
+		var found bool
+		for _, scheme := range opt.schemes {
+			if strings.EqualFold(scheme, u.Scheme) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if opt.noUserInfo && u.User != nil {
+		return false
+	}
+	if opt.requireTLD && !IsFQDN(host) {
+		return false
+	}
+	if opt.noPrivateIP && isPrivateHost(host) {
+		return false
+	}
+	return true
+}
+
+// the private, loopback, link-local and other non-public IP ranges.
+var privateIPRanges = []string{
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16",
+	"172.16.0.0/12", "192.0.0.0/24", "192.168.0.0/16", "198.18.0.0/15", "224.0.0.0/4", "240.0.0.0/4",
+	"::/128", "::1/128", "fc00::/7", "fe80::/10", "ff00::/8",
+}
+
+var (
+	privateIPOnce sync.Once
+	privateIPNets []*net.IPNet
+)
+
+// check the host is a private IP address, or "localhost".
+// the numeric host that is not a standard IP is also rejected. eg: "2130706433", "0x7f.1"
+func isPrivateHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		// the last label is not a valid TLD, it may be parsed as an IP by some clients.
+		last := host[strings.LastIndexByte(host, '.')+1:]
+		return last != "" && (isDigits(last) || strings.HasPrefix(last, "0x"))
+	}
+
+	privateIPOnce.Do(func() {
+		for _, cidr := range privateIPRanges {
+			_, ipNet, _ := net.ParseCIDR(cidr)
+			privateIPNets = append(privateIPNets, ipNet)
+		}
+	})
+
+	for _, ipNet := range privateIPNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
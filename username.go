@@ -0,0 +1,199 @@
+package validate
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// the built-in reserved usernames
+const reservedNameTable = `
+about abuse account accounts admin administrator api app apps assets auth
+billing blog bot cdn contact dashboard dev docs help home hostmaster
+info login logout mail me news noreply no-reply null official oauth
+owner postmaster privacy register root security settings signin signout signup
+staff static status support system team test terms undefined user users
+webmaster www
+`
+
+// ReservedNameProvider check the username is reserved. see SetReservedNameProvider()
+type ReservedNameProvider interface {
+	IsReserved(name string) bool
+}
+
+// NameList is a concurrency safe and updatable name list, it implements the ReservedNameProvider.
+// the names are case insensitive.
+type NameList struct {
+	mu    sync.RWMutex
+	names map[string]bool
+}
+
+// NewNameList create a name list.
+func NewNameList(names ...string) *NameList {
+	nl := &NameList{names: make(map[string]bool, len(names))}
+	nl.Add(names...)
+	return nl
+}
+
+// Add names to the list.
+func (nl *NameList) Add(names ...string) {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+
+	for _, name := range names {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			nl.names[name] = true
+		}
+	}
+}
+
+// Remove names from the list.
+func (nl *NameList) Remove(names ...string) {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+
+	for _, name := range names {
+		delete(nl.names, strings.ToLower(strings.TrimSpace(name)))
+	}
+}
+
+// Has check the name is in the list.
+func (nl *NameList) Has(name string) bool {
+	nl.mu.RLock()
+	defer nl.mu.RUnlock()
+	return nl.names[strings.ToLower(name)]
+}
+
+// IsReserved check the name is reserved. implements the ReservedNameProvider
+func (nl *NameList) IsReserved(name string) bool {
+	return nl.Has(name)
+}
+
+var (
+	reservedOnce sync.Once
+	reservedList *NameList
+	// custom reserved name provider
+	reservedProvider ReservedNameProvider
+)
+
+// ReservedNames get the built-in reserved username list, can add or remove names of it.
+//
+// Usage:
+// 	validate.ReservedNames().Add("billing-team")
+func ReservedNames() *NameList {
+	reservedOnce.Do(func() {
+		reservedList = NewNameList(strings.Fields(reservedNameTable)...)
+	})
+	return reservedList
+}
+
+// SetReservedNameProvider set the custom reserved name provider for the username rule.
+// set nil will reset to the built-in list.
+func SetReservedNameProvider(p ReservedNameProvider) {
+	reservedProvider = p
+}
+
+// check the username is reserved by the provider.
+func isReservedName(name string) bool {
+	if reservedProvider != nil {
+		return reservedProvider.IsReserved(name)
+	}
+	return ReservedNames().IsReserved(name)
+}
+
+// the options of the username rule. see IsUsername()
+type usernameOptions struct {
+	min, max      int
+	charset       string
+	symbols       string
+	noPrefixes    []string
+	noSuffixes    []string
+	allowReserved bool
+}
+
+// parse the username rule options. eg: "min=3", "charset=lower", "noPrefix=_", "allowReserved"
+func parseUsernameOptions(options []string) (opt usernameOptions, ok bool) {
+	opt = usernameOptions{min: 3, max: 32, charset: "alnum", symbols: "_.-"}
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		key, val := option, ""
+		if pos := strings.IndexByte(option, '='); pos > 0 {
+			key, val = option[:pos], option[pos+1:]
+		}
+
+		var err error
+		switch key {
+		case "":
+		case "min":
+			opt.min, err = strconv.Atoi(val)
+		case "max":
+			opt.max, err = strconv.Atoi(val)
+		case "charset":
+			if val != "alnum" && val != "lower" && val != "unicode" {
+				configErrorf("invalid username charset '%s', allow: alnum, lower, unicode", val)
+				return opt, false
+			}
+			opt.charset = val
+		case "symbols":
+			opt.symbols = val
+		case "noPrefix":
+			opt.noPrefixes = append(opt.noPrefixes, val)
+		case "noSuffix":
+			opt.noSuffixes = append(opt.noSuffixes, val)
+		case "allowReserved":
+			opt.allowReserved = true
+		default:
+			configErrorf("invalid username option '%s', allow: min, max, charset, symbols, noPrefix, noSuffix, allowReserved", option)
+			return opt, false
+		}
+
+		if err != nil {
+			configErrorf("invalid username option '%s', the min and max must be positive integer", option)
+			return opt, false
+		}
+	}
+
+	// check the range after all options are parsed. eg: "min=40", "max=60"
+	if opt.min < 1 || opt.max < opt.min {
+		configErrorf("invalid username length range [%d, %d], the min must be positive integer and min <= max", opt.min, opt.max)
+		return opt, false
+	}
+	return opt, true
+}
+
+// check the username by the options.
+func checkUsername(s string, opt usernameOptions) bool {
+	if n := utf8.RuneCountInString(s); n < opt.min || n > opt.max {
+		return false
+	}
+
+	for _, r := range s {
+		var ok bool
+		switch opt.charset {
+		case "lower":
+			ok = r >= 'a' && r <= 'z' || r >= '0' && r <= '9'
+		case "unicode":
+			ok = unicode.IsLetter(r) || unicode.IsDigit(r)
+		default:
+			ok = r < utf8.RuneSelf && isAlnum(byte(r))
+		}
+
+		if !ok && !strings.ContainsRune(opt.symbols, r) {
+			return false
+		}
+	}
+
+	for _, prefix := range opt.noPrefixes {
+		if prefix != "" && strings.HasPrefix(s, prefix) {
+			return false
+		}
+	}
+	for _, suffix := range opt.noSuffixes {
+		if suffix != "" && strings.HasSuffix(s, suffix) {
+			return false
+		}
+	}
+	return opt.allowReserved || !isReservedName(s)
+}
@@ -1,14 +1,19 @@
 package validate
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"unicode"
 
 	"github.com/gookit/filter"
+	"github.com/gookit/goutil/arrutil"
 	"github.com/gookit/goutil/mathutil"
 	"github.com/gookit/goutil/strutil"
 )
@@ -46,6 +51,159 @@ func CallByValue(fv reflect.Value, args ...interface{}) []reflect.Value {
 	return fv.Call(in)
 }
 
+// the special chars in the rule string, they can be escaped by the backslash. eg: "\,"
+const ruleEscapeChars = `,|:"[]\`
+
+// check the char at the index is escaped by the backslash
+func isRuleEscape(s string, i int) bool {
+	return s[i] == '\\' && i+1 < len(s) && strings.IndexByte(ruleEscapeChars, s[i+1]) >= 0
+}
+
+// split the rule string by the separator. the separator in the double quotes or
+// escaped by the backslash is ignored, the quotes and escapes are kept for parse the args.
+// the separator in the parentheses of the rule groups is ignored too. eg: "or:(email)(string|minLen:3)"
+//
+// eg: `required|regexp:"a|b"|in:a\|b` -> ["required", `regexp:"a|b"`, `in:a\|b`]
+func splitRule(rule string, sep byte) (ss []string) {
+	var quoted bool
+	var start, depth int
+	for i := 0; i < len(rule); i++ {
+		switch {
+		case isRuleEscape(rule, i):
+			i++
+		case rule[i] == '"':
+			quoted = !quoted
+		case quoted:
+		case rule[i] == '(' && isRuleGroups(rule[start:i]):
+			depth++
+		case rule[i] == ')' && depth > 0:
+			depth--
+		case rule[i] == sep && depth == 0:
+			if item := strings.TrimSpace(rule[start:i]); item != "" {
+				ss = append(ss, item)
+			}
+			start = i + 1
+		}
+	}
+
+	if item := strings.TrimSpace(rule[start:]); item != "" {
+		ss = append(ss, item)
+	}
+	return
+}
+
+// split the validator name and the arg string. eg: "min:12" -> "min", "12"
+func splitRuleArgs(validator string) (name, argStr string) {
+	nodes := strings.SplitN(validator, ":", 2)
+	if len(nodes) == 1 {
+		return strings.TrimSpace(nodes[0]), ""
+	}
+	return strings.TrimSpace(nodes[0]), strings.TrimSpace(nodes[1])
+}
+
+// remove the double quotes around the arg. the escaped quotes in the arg are unescaped.
+// eg: `"^a{2,4}$"` -> `^a{2,4}$`
+func unquoteArg(arg string) string {
+	if len(arg) > 1 && arg[0] == '"' && arg[len(arg)-1] == '"' {
+		return strings.Replace(arg[1:len(arg)-1], `\"`, `"`, -1)
+	}
+	return arg
+}
+
+// parse the arg string. the args are separated by comma, support:
+//  - double quoted arg, the separators in it are literal. eg: `"a,b",c`
+//  - escape the special chars by the backslash. eg: `a\,b,c`
+//  - bracketed list, it is parsed as a []string arg. eg: `[a, "b,c"],d`
+//  - JSON literal, it is parsed once and cached. see parseJSONArg(). eg: `json({"min": 1}),d`
+//
+// returns the arg is string, []string or the JSON value
+func parseRuleArgs(argStr string) (args []interface{}) {
+	var sb strings.Builder
+	var list []string
+	// quoted: current item has quotes. inList: in the brackets
+	var inQuote, quoted, inList bool
+
+	flushItem := func() (item string, ok bool) {
+		item = sb.String()
+		sb.Reset()
+		if !quoted {
+			item = strings.TrimSpace(item)
+		}
+
+		ok = quoted || item != ""
+		quoted = false
+		return
+	}
+
+	for i := 0; i < len(argStr); i++ {
+		c := argStr[i]
+		switch {
+		case isRuleEscape(argStr, i):
+			i++
+			sb.WriteByte(argStr[i])
+		case inQuote:
+			if c == '"' {
+				inQuote = false
+			} else {
+				sb.WriteByte(c)
+			}
+		case c == '"':
+			// ignore the spaces around the quoted item
+			if strings.TrimSpace(sb.String()) == "" {
+				sb.Reset()
+			}
+			inQuote, quoted = true, true
+		case c == ' ' && quoted:
+		case c == 'j' && !inList && !quoted && strings.TrimSpace(sb.String()) == "" &&
+			strings.HasPrefix(argStr[i:], jsonArgPrefix):
+			start := i + len(jsonArgPrefix)
+			end := jsonArgEnd(argStr, start)
+			if end < 0 {
+				sb.WriteByte(c)
+				continue
+			}
+
+			sb.Reset()
+			args = append(args, parseJSONArg(argStr[start:end]))
+			i = end
+		case c == '[' && !inList && !quoted && strings.TrimSpace(sb.String()) == "":
+			sb.Reset()
+			inList, list = true, []string{}
+		case c == ']' && inList:
+			if item, ok := flushItem(); ok {
+				list = append(list, item)
+			}
+			inList = false
+		case c == ',':
+			item, ok := flushItem()
+			if inList {
+				if ok {
+					list = append(list, item)
+				}
+			} else if list != nil {
+				args = append(args, list)
+				list = nil
+			} else if ok {
+				args = append(args, item)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	if item, ok := flushItem(); inList && ok {
+		list = append(list, item)
+	} else if list == nil && ok {
+		args = append(args, item)
+	}
+
+	if list != nil {
+		args = append(args, list)
+	}
+	return
+}
+
+// parse the arg string to the string list, the bracketed list and JSON array are flattened. see parseRuleArgs()
 func parseArgString(argStr string) (ss []string) {
 	if argStr == "" { // no arg
 		return
@@ -54,7 +212,64 @@ func parseArgString(argStr string) (ss []string) {
 	if len(argStr) == 1 { // one char
 		return []string{argStr}
 	}
-	return stringSplit(argStr, ",")
+
+	for _, arg := range parseRuleArgs(argStr) {
+		switch tv := arg.(type) {
+		case string:
+			ss = append(ss, tv)
+		case []string:
+			ss = append(ss, tv...)
+		case []int64, []float64, []interface{}:
+			list, _ := arrutil.ToStrings(tv)
+			ss = append(ss, list...)
+		default:
+			ss = append(ss, strutil.MustString(tv))
+		}
+	}
+	return
+}
+
+// check the string has any of the prefixes or suffixes. fold: compare case-insensitively.
+func hasAffix(s string, affixes []string, fn func(s, affix string) bool, fold bool) bool {
+	if fold {
+		s = strings.ToLower(s)
+	}
+
+	for _, affix := range affixes {
+		if fold {
+			affix = strings.ToLower(affix)
+		}
+		if fn(s, affix) {
+			return true
+		}
+	}
+	return false
+}
+
+// the case-insensitive flag of the prefix, suffix rules. eg: "startsWith:img_,pic_:i"
+const foldFlag = ":i"
+
+// the case-insensitive validators of the prefix, suffix rules
+var foldAffixValidators = map[string]string{
+	"startsWith":    "startsWithFold",
+	"endsWith":      "endsWithFold",
+	"notStartsWith": "notStartsWithFold",
+	"notEndsWith":   "notEndsWithFold",
+}
+
+// get the real validator name and the arg string for the prefix, suffix rule with the case-insensitive flag.
+// eg: "startsWith", "img_,pic_:i" -> "startsWithFold", "img_,pic_"
+func foldAffixRule(realName, argStr string) (string, string) {
+	foldName, ok := foldAffixValidators[realName]
+	if !ok || !strings.HasSuffix(argStr, foldFlag) {
+		return realName, argStr
+	}
+
+	// the escaped colon is literal. eg: "startsWith:abc\:i"
+	if end := len(argStr) - len(foldFlag); end == 0 || argStr[end-1] != '\\' {
+		return foldName, argStr[:end]
+	}
+	return realName, argStr
 }
 
 func stringSplit(str, sep string) (ss []string) {
@@ -71,6 +286,90 @@ func stringSplit(str, sep string) (ss []string) {
 	return
 }
 
+// set value to the map by dot-path. eg: "top.sub", "top.list.0".
+// will create intermediate maps on the sub-key not exists.
+func setByPath(mp map[string]interface{}, path string, val interface{}) error {
+	var node interface{} = mp
+	keys := strings.Split(path, ".")
+	lastIdx := len(keys) - 1
+
+	for i, key := range keys {
+		if m, ok := node.(M); ok {
+			node = map[string]interface{}(m)
+		}
+
+		switch tn := node.(type) {
+		case map[string]interface{}:
+			if i == lastIdx {
+				tn[key] = val
+				return nil
+			}
+
+			sub, ok := tn[key]
+			if !ok || sub == nil {
+				sub = make(map[string]interface{})
+				tn[key] = sub
+			}
+			node = sub
+		case []interface{}:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(tn) {
+				return fmt.Errorf("invalid slice index '%s' in the path '%s'", key, path)
+			}
+
+			if i == lastIdx {
+				tn[idx] = val
+				return nil
+			}
+
+			if tn[idx] == nil {
+				tn[idx] = make(map[string]interface{})
+			}
+			node = tn[idx]
+		default:
+			return fmt.Errorf("cannot set value by path '%s', the '%s' is %T", path, strings.Join(keys[:i], "."), node)
+		}
+	}
+	return nil
+}
+
+// parse time string by the layouts.
+// on the layouts is empty, will auto match commonly layout. see strutil.ToTime()
+func parseTime(s string, layouts []string) (t time.Time, err error) {
+	if len(layouts) == 0 {
+		return strutil.ToTime(s)
+	}
+
+	for _, layout := range layouts {
+		if t, err = time.Parse(layout, s); err == nil {
+			return
+		}
+	}
+	return
+}
+
+// convert the dot-path key to bracket-notation key. eg: "user.name" -> "user[name]"
+func toBracketKey(key string) string {
+	nodes := strings.Split(key, ".")
+	if len(nodes) == 1 {
+		return key
+	}
+	return nodes[0] + "[" + strings.Join(nodes[1:], "][") + "]"
+}
+
+// typesString describe types of the values. eg: "(int, string)"
+func typesString(vals []interface{}) string {
+	ss := make([]string, len(vals))
+	for i, val := range vals {
+		if val == nil {
+			ss[i] = "nil"
+		} else {
+			ss[i] = reflect.TypeOf(val).String()
+		}
+	}
+	return "(" + strings.Join(ss, ", ") + ")"
+}
+
 // TODO use arrutil.StringsToSlice()
 func strings2Args(strings []string) []interface{} {
 	args := make([]interface{}, len(strings))
@@ -98,8 +397,55 @@ func buildArgs(val interface{}, args []interface{}) []interface{} {
 	return newArgs
 }
 
+var (
+	emptyCheckerMu sync.RWMutex
+	// the custom emptiness checkers by the value type. see RegisterEmptyChecker()
+	emptyCheckers = make(map[reflect.Type]func(val interface{}) bool)
+)
+
+// RegisterEmptyChecker register the emptiness checker for the type. it is used by IsEmpty(),
+// so the domain types work with the "required" validators and the skip on empty logic.
+// the checker of the type is also used for the non-nil pointer to it. pass nil fn to remove it.
+//
+// Usage:
+// 	validate.RegisterEmptyChecker(reflect.TypeOf(Money{}), func(val interface{}) bool {
+// 		return val.(Money).Cents == 0
+// 	})
+func RegisterEmptyChecker(typ reflect.Type, fn func(val interface{}) bool) {
+	emptyCheckerMu.Lock()
+	defer emptyCheckerMu.Unlock()
+
+	if fn == nil {
+		delete(emptyCheckers, typ)
+	} else {
+		emptyCheckers[typ] = fn
+	}
+}
+
+// find the custom emptiness checker of the value
+func emptyCheckerOf(v reflect.Value) (fn func(val interface{}) bool, rv reflect.Value) {
+	emptyCheckerMu.RLock()
+	defer emptyCheckerMu.RUnlock()
+
+	if len(emptyCheckers) == 0 || !v.IsValid() {
+		return
+	}
+	if fn = emptyCheckers[v.Type()]; fn != nil {
+		return fn, v
+	}
+
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		return emptyCheckers[v.Elem().Type()], v.Elem()
+	}
+	return
+}
+
 // ValueIsEmpty check. TODO use stdutil.ValueIsEmpty()
 func ValueIsEmpty(v reflect.Value) bool {
+	if fn, rv := emptyCheckerOf(v); fn != nil && rv.CanInterface() {
+		return fn(rv.Interface())
+	}
+
 	switch v.Kind() {
 	case reflect.Invalid:
 		return true
@@ -167,6 +513,9 @@ func valueToInt64(v interface{}, strict bool) (i64 int64, err error) {
 	case int64:
 		i64 = tVal
 	case uint:
+		if uint64(tVal) > math.MaxInt64 {
+			return 0, ErrConvertFail
+		}
 		i64 = int64(tVal)
 	case uint8:
 		i64 = int64(tVal)
@@ -175,6 +524,9 @@ func valueToInt64(v interface{}, strict bool) (i64 int64, err error) {
 	case uint32:
 		i64 = int64(tVal)
 	case uint64:
+		if tVal > math.MaxInt64 {
+			return 0, ErrConvertFail
+		}
 		i64 = int64(tVal)
 	case float32:
 		if strict {
@@ -241,6 +593,11 @@ func compareIntFloat(srcVal, dstVal interface{}, op string) (ok bool) {
 		return false
 	}
 
+	// exact compare for the decimal and big number. eg: shopspring/decimal.Decimal, *big.Int
+	if isBigNumber(srcVal) || isBigNumber(dstVal) {
+		return compareDecimal(srcVal, dstVal, op)
+	}
+
 	if srcFlt, ok := srcVal.(float64); ok {
 		dstFlt, err := mathutil.ToFloat(dstVal)
 		if err != nil {
@@ -260,12 +617,13 @@ func compareIntFloat(srcVal, dstVal interface{}, op string) (ok bool) {
 	// as int64
 	srcInt, err := mathutil.Int64(srcVal)
 	if err != nil {
-		return false
+		// the integer string exceeding int64. eg: 128-bit ID
+		return isIntString(srcVal) && compareDecimal(srcVal, dstVal, op)
 	}
 
 	dstInt, err := mathutil.Int64(dstVal)
 	if err != nil {
-		return false
+		return isIntString(dstVal) && compareDecimal(srcVal, dstVal, op)
 	}
 
 	return compareInt64(srcInt, dstInt, op)
@@ -384,31 +742,72 @@ func convToBasicType(val interface{}) (value interface{}, err error) {
 var (
 	emptyValue = reflect.Value{}
 	errorType  = reflect.TypeOf((*error)(nil)).Elem()
+	// the context type for the validator func first arg. see funcMeta.withCtx
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
 	// fmtStringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
 	// reflectValueType = reflect.TypeOf((*reflect.Value)(nil)).Elem()
 )
 
+// panic message prefix, use for detect configuration problems.
+const panicPrefix = "validate: "
+
 func panicf(format string, args ...interface{}) {
-	panic("validate: " + fmt.Sprintf(format, args...))
+	panic(panicPrefix + fmt.Sprintf(format, args...))
+}
+
+// report internal warning message by the logger
+func logf(format string, args ...interface{}) {
+	if logger != nil {
+		logger.Printf(panicPrefix+format, args...)
+	}
+}
+
+// configErrorf report a configuration problem.
+// on GlobalOption.NoPanic=true, will report it by the logger, otherwise will panic.
+func configErrorf(format string, args ...interface{}) {
+	if gOpt.NoPanic {
+		logf(format, args...)
+		return
+	}
+	panicf(format, args...)
+}
+
+// recoverConfigError convert the recovered value to error, if it is a
+// configuration problem(panic by panicf). will re-panic on other panic value.
+func recoverConfigError(r interface{}) error {
+	if r == nil {
+		return nil
+	}
+
+	if msg, ok := r.(string); ok && strings.HasPrefix(msg, panicPrefix) {
+		msg = msg[len(panicPrefix):]
+		logf("%s", msg)
+		return errors.New(msg)
+	}
+	panic(r)
 }
 
 func checkValidatorFunc(name string, fn interface{}) reflect.Value {
 	if !goodName(name) {
-		panicf("validate name %s is not a valid identifier", name)
+		configErrorf("validate name %s is not a valid identifier", name)
+		return emptyValue
 	}
 
 	fv := reflect.ValueOf(fn)
 	if fn == nil || fv.Kind() != reflect.Func { // is nil or not is func
-		panicf("validator '%s'. 2th parameter is invalid, it must be an func", name)
+		configErrorf("validator '%s'. 2th parameter is invalid, it must be an func", name)
+		return emptyValue
 	}
 
 	ft := fv.Type()
-	if ft.NumIn() == 0 {
-		panicf("validator '%s' func at least one parameter position", name)
+	if ft.NumIn() == 0 || ft.NumIn() == 1 && ft.In(0) == contextType {
+		configErrorf("validator '%s' func at least one parameter position", name)
+		return emptyValue
 	}
 
 	if ft.NumOut() != 1 || ft.Out(0).Kind() != reflect.Bool {
-		panicf("validator '%s' func must be return a bool value", name)
+		configErrorf("validator '%s' func must be return a bool value", name)
+		return emptyValue
 	}
 
 	return fv
@@ -416,21 +815,25 @@ func checkValidatorFunc(name string, fn interface{}) reflect.Value {
 
 func checkFilterFunc(name string, fn interface{}) reflect.Value {
 	if !goodName(name) {
-		panicf("filter name %s is not a valid identifier", name)
+		configErrorf("filter name %s is not a valid identifier", name)
+		return emptyValue
 	}
 
 	fv := reflect.ValueOf(fn)
 	if fn == nil || fv.Kind() != reflect.Func { // is nil or not is func
-		panicf("filter '%s'. 2th parameter is invalid, it must be an func", name)
+		configErrorf("filter '%s'. 2th parameter is invalid, it must be an func", name)
+		return emptyValue
 	}
 
 	ft := fv.Type()
 	if ft.NumIn() == 0 {
-		panicf("filter '%s' func at least one parameter position", name)
+		configErrorf("filter '%s' func at least one parameter position", name)
+		return emptyValue
 	}
 
 	if !goodFunc(ft) {
-		panicf("can't install method/function %q with %d results", name, ft.NumOut())
+		configErrorf("can't install method/function %q with %d results", name, ft.NumOut())
+		return emptyValue
 	}
 
 	return fv
@@ -623,6 +1026,43 @@ func removeValuePtr(t reflect.Value) reflect.Value {
 	return t
 }
 
+// trim the string values, include the string elements of the slice and map.
+// the empty cutset is trim the whitespace. the input slice and map are not changed.
+func trimStringValues(val interface{}, cutset string) interface{} {
+	switch tv := val.(type) {
+	case string:
+		if cutset == "" {
+			return strings.TrimSpace(tv)
+		}
+		return strings.Trim(tv, cutset)
+	case []string:
+		ss := make([]string, len(tv))
+		for i, s := range tv {
+			ss[i] = trimStringValues(s, cutset).(string)
+		}
+		return ss
+	case []interface{}:
+		list := make([]interface{}, len(tv))
+		for i, item := range tv {
+			list[i] = trimStringValues(item, cutset)
+		}
+		return list
+	case map[string]interface{}:
+		mp := make(map[string]interface{}, len(tv))
+		for key, item := range tv {
+			mp[key] = trimStringValues(item, cutset)
+		}
+		return mp
+	case map[string]string:
+		mp := make(map[string]string, len(tv))
+		for key, s := range tv {
+			mp[key] = trimStringValues(s, cutset).(string)
+		}
+		return mp
+	}
+	return val
+}
+
 // ---- From package "text/template" -> text/template/exec.go
 
 // indirect returns the item at the end of indirection, and a bool to indicate if it's nil.
@@ -89,6 +89,26 @@ type GlobalOption struct {
 	ErrKeyFmt int8
 	// CheckSubOnParentMarked True: only collect sub-struct rule on current field has rule.
 	CheckSubOnParentMarked bool
+	// MaxDepth the max nesting depth for collect rules from sub-struct(s).
+	// exceeding it will report an error instead of stack overflow.
+	//
+	// default: 32
+	MaxDepth int
+	// TimeLayouts the accepted layouts for parse time string. use for the date
+	// rules and binding string value to time.Time fields.
+	//
+	// default is empty, will auto match commonly layout. eg: "2006-01-02", time.RFC3339
+	TimeLayouts []string
+	// NoPanic If true: configuration problems(unknown validator name, bad validator/filter func ...)
+	// will not panic, they will be reported by the logger and as validate errors.
+	//
+	// see SetLogger()
+	NoPanic bool
+	// LengthMode how to measure the string length for the length validators.
+	// allow: LengthModeRune, LengthModeGrapheme
+	//
+	// default: LengthModeRune
+	LengthMode string
 }
 
 // global options
@@ -109,6 +129,24 @@ func Option() GlobalOption {
 	return *gOpt
 }
 
+// Logger definition. use for report internal warnings, such as configuration problems.
+//
+// The std *log.Logger is implemented it.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// logger for report internal warnings. default is nil, will not report.
+var logger Logger
+
+// SetLogger set the logger for report internal warnings.
+//
+// Usage:
+// 	validate.SetLogger(log.New(os.Stderr, "", log.LstdFlags))
+func SetLogger(l Logger) {
+	logger = l
+}
+
 func newGlobalOption() *GlobalOption {
 	return &GlobalOption{
 		StopOnError: true,
@@ -122,12 +160,16 @@ func newGlobalOption() *GlobalOption {
 		MessageTag: messageTag,
 		// tag name in struct tags
 		ValidateTag: validateTag,
+		// max depth for sub-struct
+		MaxDepth: defaultMaxDepth,
 	}
 }
 
 func newValidation(data DataFace) *Validation {
 	v := &Validation{
 		Errors: make(Errors),
+		// soft constraint messages
+		Warnings: make(Errors),
 		// add data source on usage
 		data: data,
 		// create message translator
@@ -160,6 +202,15 @@ func newValidation(data DataFace) *Validation {
 		"gteField": reflect.ValueOf(v.GteField),
 		"ltField":  reflect.ValueOf(v.LtField),
 		"lteField": reflect.ValueOf(v.LteField),
+		// constant-time compare, for the secret values
+		"secureEqField": reflect.ValueOf(v.SecureEqField),
+		// field date compare
+		"afterField":         reflect.ValueOf(v.AfterField),
+		"afterOrEqualField":  reflect.ValueOf(v.AfterOrEqualField),
+		"beforeField":        reflect.ValueOf(v.BeforeField),
+		"beforeOrEqualField": reflect.ValueOf(v.BeforeOrEqualField),
+		// postal code, allow read the country from other field
+		"postalCode": reflect.ValueOf(v.PostalCode),
 		// file upload check
 		"isFile":      reflect.ValueOf(v.IsFormFile),
 		"isImage":     reflect.ValueOf(v.IsFormImage),
@@ -296,6 +347,7 @@ func FromJSONBytes(bs []byte) (*MapData, error) {
 func FromStruct(s interface{}) (*StructData, error) {
 	data := &StructData{
 		ValidateTag: gOpt.ValidateTag,
+		MaxDepth:    gOpt.MaxDepth,
 		// init map
 		fieldNames:  make(map[string]int8),
 		fieldValues: make(map[string]reflect.Value),
@@ -1,6 +1,9 @@
 package validate
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -25,6 +28,17 @@ func (v *Validation) ValidateData(data DataFace) bool {
 	return v.Validate()
 }
 
+// ValidateCtx do validate processing with context.
+//
+// validating will abort between fields when ctx is canceled or deadline
+// exceeded, and the result will be marked as incomplete. see IsIncomplete()
+func (v *Validation) ValidateCtx(ctx context.Context, scene ...string) bool {
+	v.setContext(ctx)
+	defer v.setContext(nil)
+
+	return v.Validate(scene...)
+}
+
 // ValidateE do validate processing and return error
 func (v *Validation) ValidateE(scene ...string) Errors {
 	if v.Validate(scene...) {
@@ -52,6 +66,9 @@ func (v *Validation) ValidateAll(scene ...string) bool {
 	// apply rule to validate data.
 	for _, rule := range v.rules {
 		rule.Apply(v)
+		if v.incomplete {
+			break
+		}
 	}
 
 	v.hasValidated = true
@@ -95,36 +112,80 @@ func (v *Validation) Validate(scene ...string) bool {
 	return v.IsSuccess()
 }
 
+// mark all fields of the rule are skipped.
+func (r *Rule) markSkipped(v *Validation, reason string) {
+	for _, field := range r.fields {
+		v.markSkipped(field, reason)
+	}
+}
+
 // Apply current rule for the rule fields
 func (r *Rule) Apply(v *Validation) (stop bool) {
 	// scene name is not match. skip the rule
 	if r.scene != "" && r.scene != v.scene {
+		r.markSkipped(v, SkipByScene)
 		return
 	}
 
 	// has beforeFunc and it returns FALSE, skip validate
 	if r.beforeFunc != nil && !r.beforeFunc(v) {
+		r.markSkipped(v, SkipByBefore)
 		return
 	}
 
+	// has "when" expression and it is false, skip validate
+	for _, e := range r.whens {
+		if !e.match(v) {
+			r.markSkipped(v, SkipByWhen)
+			return
+		}
+	}
+
 	var err error
+	var field string
 	// get real validator name
 	name := r.realName
 	// validator name is not "required"
 	isNotRequired := r.nameNotRequired
 
+	// NoPanic: report configuration problems as validate error.
+	if gOpt.NoPanic {
+		defer func() {
+			if err := recoverConfigError(recover()); err != nil {
+				v.AddError(field, r.validator, err.Error())
+				stop = v.StopOnError
+			}
+		}()
+	}
+
 	// validate each field
-	for _, field := range r.fields {
-		if v.isNotNeedToCheck(field) {
+	for _, field = range r.fields {
+		if v.isCanceled() {
+			return true
+		}
+
+		if reason := v.skipReason(field); reason != "" {
+			v.markSkipped(field, reason)
 			continue
 		}
 
 		// uploaded file validate
 		if isFileValidator(name) {
 			status := r.fileValidate(field, name, v)
+			if status == statusSkip {
+				v.markSkipped(field, SkipByEmpty)
+				continue
+			}
+
+			v.markValidated(field, status == statusOk || r.warn)
 			if status == statusFail {
+				if r.warn {
+					v.addWarning(field, r.validator, r.errorMessage(field, r.validator, nil, v))
+					continue
+				}
+
 				// build and collect error message
-				v.AddError(field, r.validator, r.errorMessage(field, r.validator, v))
+				v.AddError(field, r.validator, r.errorMessage(field, r.validator, nil, v))
 				if v.StopOnError {
 					return true
 				}
@@ -150,20 +211,25 @@ func (r *Rule) Apply(v *Validation) (stop bool) {
 			// dont need check default value
 			if !v.CheckDefault {
 				v.safeData[field] = val // save validated value.
+				v.markSkipped(field, SkipByDefault)
 				continue
 			}
 
 			// go on check custom default value
 			exist = true
 		} else if r.optional { // r.optional=true. skip check.
+			v.markSkipped(field, SkipByOptional)
 			continue
 		}
 
 		// apply filter func.
 		if exist && r.filterFunc != nil {
 			if val, err = r.filterFunc(val); err != nil {
-				v.AddError(filterError, filterError, err.Error())
-				return true
+				v.addFilterError(field, "filterFunc", err)
+				if v.StopOnError {
+					return true
+				}
+				continue
 			}
 
 			// update source field value
@@ -183,15 +249,28 @@ func (r *Rule) Apply(v *Validation) (stop bool) {
 		}
 
 		// empty value AND is not required* AND skip on empty.
-		if r.skipEmpty && isNotRequired && IsEmpty(val) {
+		if r.skipEmpty && isNotRequired && v.isEmptyValue(val) {
+			v.markSkipped(field, SkipByEmpty)
 			continue
 		}
 
 		// validate field value
-		if r.valueValidate(field, name, val, v) {
+		ok, err := r.timedValueValidate(field, name, val, v)
+		// the validating is aborted on validating the field, the result is incomplete.
+		if v.isCanceled() {
+			return true
+		}
+		v.markValidated(field, err == nil && (ok || r.warn))
+
+		if err != nil {
+			v.AddError(field, r.validator, err.Error())
+		} else if ok {
 			v.safeData[field] = val // save validated value.
+		} else if r.warn { // soft constraint, the value is accepted.
+			v.addWarning(field, r.validator, r.errorMessage(field, r.validator, val, v))
+			v.safeData[field] = val
 		} else { // build and collect error message
-			v.AddError(field, r.validator, r.errorMessage(field, r.validator, v))
+			v.AddError(field, r.validator, r.errorMessage(field, r.validator, val, v))
 		}
 
 		// stop on error
@@ -243,11 +322,137 @@ func (r *Rule) fileValidate(field, name string, v *Validation) uint8 {
 	return statusFail
 }
 
-// validate the field value
-func (r *Rule) valueValidate(field, name string, val interface{}, v *Validation) (ok bool) {
+// validate the field value with the execution timeout of the validator. see Validation.RuleTimeout()
+// returns the "validation timeout" error on the validator runs out of the time.
+//
+// the value is prepared in the current goroutine, only the validator func runs in the background.
+// the validator func gets the context canceled on the timeout by the first arg context.Context.
+// eg: func(ctx context.Context, val string) bool
+//
+// returns false without error on the validating context is canceled, see ValidateCtx()
+func (r *Rule) timedValueValidate(field, name string, val interface{}, v *Validation) (ok bool, err error) {
+	timeout, has := v.ruleTimeouts[name]
+	if !has {
+		return r.safeValueValidate(field, name, val, v)
+	}
+
+	parent := v.Context()
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+
+	var check func(ctx context.Context) bool
+	defer func() {
+		if re := recover(); re != nil {
+			err = r.recoverError(field, name, val, v, re)
+		}
+	}()
+	if check, err = r.prepareCheck(field, name, val, v); check == nil {
+		return false, err
+	}
+
+	type result struct {
+		ok bool
+		// the panic value of the validator
+		panicVal interface{}
+	}
+
+	// buffered, the abandoned validator will not be blocked.
+	ch := make(chan result, 1)
+	go func() {
+		var res result
+		defer func() {
+			res.panicVal = recover()
+			ch <- res
+		}()
+
+		res.ok = check(ctx)
+	}()
+
+	select {
+	case res := <-ch:
+		if res.panicVal != nil {
+			panic(res.panicVal)
+		}
+		return res.ok, nil
+	case <-ctx.Done():
+		// canceled by the caller, the result is incomplete
+		if parent.Err() != nil {
+			return false, nil
+		}
+
+		logf("validator '%s' on field '%s' is abandoned, exceeded the timeout %s", r.validator, field, timeout)
+		return false, errors.New(v.trans.Message(timeoutError, field))
+	}
+}
+
+// validate the field value, will recover the panic on call validator func.
+// eg: custom validator panic, the reflect.Call arguments type mismatch.
+//
+// NOTICE: configuration problems(panic by panicf) will not be recovered.
+func (r *Rule) safeValueValidate(field, name string, val interface{}, v *Validation) (ok bool, err error) {
+	defer func() {
+		if re := recover(); re != nil {
+			err = r.recoverError(field, name, val, v, re)
+		}
+	}()
+
+	return r.valueValidate(field, name, val, v)
+}
+
+// convert the recovered validator panic to the error, the configuration problems are re-panicked.
+func (r *Rule) recoverError(field, name string, val interface{}, v *Validation, re interface{}) error {
+	if msg, isStr := re.(string); isStr && strings.HasPrefix(msg, panicPrefix) {
+		panic(re)
+	}
+
+	err := r.panicError(field, name, val, v, re)
+	logf("%s", err.Error())
+	return err
+}
+
+// build an actionable error for the validator panic
+func (r *Rule) panicError(field, name string, val interface{}, v *Validation, re interface{}) error {
+	fm := r.checkFuncMeta
+	if fm == nil {
+		fm = v.validatorMeta(name)
+	}
+
+	given := append([]interface{}{val}, r.arguments...)
+	if fm == nil {
+		return fmt.Errorf("validator '%s' panic on field '%s': %v, given args %s", r.validator, field, re, typesString(given))
+	}
+
+	return fmt.Errorf(
+		"validator '%s' panic on field '%s': %v, given args %s, want %s",
+		r.validator, field, re, typesString(given), fm.fv.Type().String(),
+	)
+}
+
+// validate the field value. returns error on the referenced field is missing.
+func (r *Rule) valueValidate(field, name string, val interface{}, v *Validation) (bool, error) {
+	check, err := r.prepareCheck(field, name, val, v)
+	if check == nil {
+		return false, err
+	}
+	return check(v.Context()), nil
+}
+
+// prepare the check of the field value: the field references are resolved, the value and the args
+// are converted to the validator func types. returns nil on the conversion failed,
+// and the error on the referenced field is missing. see fieldRef
+//
+// the returned check does not touch the Validation, the required* validators read the other
+// fields, they are checked in the preparation. so it can run in the background, see timedValueValidate()
+// the ctx is passed to the validator func with the context.Context first arg, and the DNS lookups.
+func (r *Rule) prepareCheck(field, name string, val interface{}, v *Validation) (func(ctx context.Context) bool, error) {
 	// "-" OR "safe" mark field value always is safe.
 	if name == "-" || name == "safe" {
-		return true
+		return checkResult(true), nil
+	}
+
+	// the alternative rule groups. eg: "or:(email)(phone:US)"
+	if len(r.groups) > 0 {
+		return checkResult(r.groupsValidate(field, val, v) != r.negate), nil
 	}
 
 	// call custom validator in the rule.
@@ -268,55 +473,110 @@ func (r *Rule) valueValidate(field, name string, val interface{}, v *Validation)
 		fm.checkArgNum(argNum, r.validator)
 	}
 
-	// 1. args data type convert
-	args := r.arguments
-	if ok = convertArgsType(v, fm, field, args); !ok {
-		return false
+	if r.enumName != "" {
+		if _, ok := EnumValues(r.enumName); !ok {
+			configErrorf("the enum '%s' is not registered", r.enumName)
+			return nil, nil
+		}
+	}
+
+	// 1. args data type convert. the field references are resolved.
+	args, missing := r.resolveArgs(v)
+	if missing != "" {
+		return nil, errors.New(v.trans.Message(fieldRefError, field, missing))
+	}
+	if !convertArgsType(v, fm, field, args) {
+		return nil, nil
 	}
 
-	ft := fm.fv.Type()
-	arg0Kind := ft.In(0).Kind()
+	arg0Kind := fm.argType(0).Kind()
+	lenMode := r.lengthMode(v, field)
 
 	// rftVal := reflect.Indirect(reflect.ValueOf(val))
 	rftVal := reflect.ValueOf(val)
 	valKind := rftVal.Kind()
 
+	var check func(ctx context.Context) bool
 	// feat: support check sub element in a slice list. eg: field=names.*
 	if valKind == reflect.Slice && strings.HasSuffix(field, ".*") {
-		var subVal interface{}
-		for i := 0; i < rftVal.Len(); i++ {
+		subVals := make([]interface{}, rftVal.Len())
+		for i := range subVals {
 			subRv := rftVal.Index(i)
 			subKind := subRv.Kind()
+			subVals[i] = subRv.Interface()
 			// 1.1 convert field value type, is func first argument.
 			if r.nameNotRequired && arg0Kind != reflect.Interface && arg0Kind != subKind {
-				subVal, ok = convValAsFuncArg0Type(arg0Kind, subKind, subRv.Interface())
+				subVal, ok := convValAsFuncArg0Type(arg0Kind, subKind, subVals[i])
 				if !ok {
-					v.convArgTypeError(field, fm.name, subKind, arg0Kind, 0)
-					return false
+					if !r.grouped {
+						v.convArgTypeError(field, fm.name, subKind, arg0Kind, 0)
+					}
+					return nil, nil
 				}
-			} else {
-				subVal = subRv.Interface()
+				subVals[i] = subVal
 			}
+		}
 
-			// 2. call built in validator
-			if !callValidator(v, fm, field, subVal, r.arguments) {
-				return false
+		// 2. call built in validator
+		check = func(ctx context.Context) bool {
+			for _, subVal := range subVals {
+				if !r.callValidator(ctx, v, fm, field, subVal, args, lenMode) {
+					return false
+				}
 			}
+			return true
+		}
+	} else {
+		// 1.1 convert field value type, is func first argument.
+		if r.nameNotRequired && arg0Kind != reflect.Interface && arg0Kind != valKind {
+			var ok bool
+			val, ok = convValAsFuncArg0Type(arg0Kind, valKind, val)
+			if !ok {
+				// the rule in a rule group, try the next group
+				if !r.grouped {
+					v.convArgTypeError(field, fm.name, valKind, arg0Kind, 0)
+				}
+				return nil, nil
+			}
+		}
+
+		// 2. call built in validator
+		check = func(ctx context.Context) bool {
+			return r.callValidator(ctx, v, fm, field, val, args, lenMode)
 		}
-		return true
 	}
 
-	// 1.1 convert field value type, is func first argument.
-	if r.nameNotRequired && arg0Kind != reflect.Interface && arg0Kind != valKind {
-		val, ok = convValAsFuncArg0Type(arg0Kind, valKind, val)
-		if !ok {
-			v.convArgTypeError(field, fm.name, valKind, arg0Kind, 0)
-			return false
+	if !r.nameNotRequired {
+		return checkResult(check(v.Context())), nil
+	}
+	return check, nil
+}
+
+// the check of the known result.
+func checkResult(ok bool) func(ctx context.Context) bool {
+	return func(context.Context) bool { return ok }
+}
+
+// call the validator. the length validators measure the string value by the length mode.
+// the result is reversed on the rule is negated, see Rule.SetNegate()
+func (r *Rule) callValidator(ctx context.Context, v *Validation, fm *funcMeta, field string, val interface{}, args []interface{}, lenMode string) bool {
+	if str, isStr := val.(string); isStr {
+		if ok, handled := checkLengthByMode(fm.name, str, lenMode, args); handled {
+			return ok != r.negate
 		}
 	}
+	return callValidator(ctx, v, fm, field, val, args) != r.negate
+}
 
-	// 2. call built in validator
-	return callValidator(v, fm, field, val, r.arguments)
+// get the length mode of the rule. priority: rule > field > global
+func (r *Rule) lengthMode(v *Validation, field string) string {
+	if r.lenMode != "" {
+		return r.lenMode
+	}
+	if mode, ok := v.lenModes[field]; ok {
+		return mode
+	}
+	return gOpt.LengthMode
 }
 
 // convert input field value type, is validator func first argument.
@@ -336,7 +596,14 @@ func convValAsFuncArg0Type(arg0Kind, valKind reflect.Kind, val interface{}) (int
 	return val, true
 }
 
-func callValidator(v *Validation, fm *funcMeta, field string, val interface{}, args []interface{}) (ok bool) {
+func callValidator(ctx context.Context, v *Validation, fm *funcMeta, field string, val interface{}, args []interface{}) (ok bool) {
+	// the date validators parse the date strings by the time layouts of the validation
+	if fm.isInternal {
+		if ok, handled := callDateValidator(fm.name, val, args, v.dateLayouts()); handled {
+			return ok
+		}
+	}
+
 	// use `switch` can avoid using reflection to call methods and improve speed
 	switch fm.name {
 	case "required":
@@ -401,13 +668,24 @@ func callValidator(v *Validation, fm *funcMeta, field string, val interface{}, a
 		ok = Regexp(val.(string), args[0].(string))
 	case "between":
 		ok = Between(val, args[0].(int64), args[1].(int64))
+	// the DNS lookups use the validation context
+	case "isEmail":
+		ok = isEmail(ctx, val.(string), args2strings(args))
+	case "isEmailMX":
+		ok = isEmail(ctx, val.(string), []string{"dns"})
+	case "isDomainResolvable":
+		ok = isDomainResolvable(ctx, val.(string), args2strings(args))
 	case "isJSON":
-		ok = IsJSON(val.(string))
+		if len(args) == 0 {
+			ok = IsJSON(val.(string))
+		} else {
+			ok = callValidatorValue(ctx, fm, val, args)
+		}
 	case "isSlice":
 		ok = IsSlice(val)
 	default:
 		// 3. call user custom validators, will call by reflect
-		ok = callValidatorValue(fm.fv, val, args)
+		ok = callValidatorValue(ctx, fm, val, args)
 	}
 	return
 }
@@ -418,7 +696,6 @@ func convertArgsType(v *Validation, fm *funcMeta, field string, args []interface
 		return true
 	}
 
-	ft := fm.fv.Type()
 	lastTyp := reflect.Invalid
 	lastArgIndex := fm.numIn - 1
 
@@ -426,7 +703,7 @@ func convertArgsType(v *Validation, fm *funcMeta, field string, args []interface
 	// eg. "...int64" -> slice "[]int64"
 	if fm.isVariadic {
 		// get variadic kind. "[]int64" -> reflect.Int64
-		lastTyp = getVariadicKind(ft.In(lastArgIndex).String())
+		lastTyp = getVariadicKind(fm.argType(lastArgIndex).String())
 	}
 
 	// only one args and type is interface{}
@@ -468,7 +745,7 @@ func convertArgsType(v *Validation, fm *funcMeta, field string, args []interface
 		}
 
 		// "+1" because func first arg is val, need skip it.
-		argIType := ft.In(fcArgIndex)
+		argIType := fm.argType(fcArgIndex)
 		wantKind = argIType.Kind()
 
 		// type is same. or want type is interface
@@ -496,7 +773,7 @@ func convertArgsType(v *Validation, fm *funcMeta, field string, args []interface
 	return true
 }
 
-func callValidatorValue(fv reflect.Value, val interface{}, args []interface{}) bool {
+func callValidatorValue(ctx context.Context, fm *funcMeta, val interface{}, args []interface{}) bool {
 	// build params for the validator func.
 	argNum := len(args)
 	argIn := make([]reflect.Value, argNum+1)
@@ -518,7 +795,12 @@ func callValidatorValue(fv reflect.Value, val interface{}, args []interface{}) b
 		argIn[i+1] = rftValA
 	}
 
+	// the context first arg. see funcMeta.withCtx
+	if fm.withCtx {
+		argIn = append([]reflect.Value{reflect.ValueOf(&ctx).Elem()}, argIn...)
+	}
+
 	// NOTICE: f.CallSlice()与Call() 不一样的是，CallSlice参数的最后一个会被展开
 	// vs := fv.Call(argIn)
-	return fv.Call(argIn)[0].Bool()
+	return fm.fv.Call(argIn)[0].Bool()
 }
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -195,7 +196,7 @@ func TestValidation_Validate_filter(t *testing.T) {
 	})
 
 	assert.False(t, v.Validate())
-	assert.Equal(t, `strconv.Atoi: parsing "abc": invalid syntax`, v.Errors.One())
+	assert.Contains(t, v.Errors.One(), `strconv.Atoi: parsing "abc": invalid syntax`)
 }
 
 func TestValidation_Validate_argHasNil(t *testing.T) {
@@ -210,3 +211,82 @@ func TestValidation_Validate_argHasNil(t *testing.T) {
 	assert.False(t, v.Validate())
 	assert.Equal(t, `age field did not pass validation`, v.Errors.One())
 }
+
+func TestRule_Apply_recoverPanic(t *testing.T) {
+	is := assert.New(t)
+
+	v := Map(M{"age": 23, "tags": 34, "name": "inhere"})
+	v.StopOnError = false
+	v.AddValidator("panicCheck", func(val interface{}) bool {
+		panic("something wrong")
+	})
+	v.AddValidator("strSlice", func(val []string) bool {
+		return len(val) > 0
+	})
+	v.StringRule("age", "panicCheck")
+	v.StringRule("tags", "strSlice")
+	v.StringRule("name", "required")
+
+	is.NotPanics(func() {
+		is.False(v.Validate())
+	})
+
+	msg := v.Errors.FieldOne("age")
+	is.Contains(msg, "validator 'panicCheck' panic on field 'age': something wrong")
+	is.Contains(msg, "given args (int), want func(interface {}) bool")
+
+	msg = v.Errors.FieldOne("tags")
+	is.Contains(msg, "validator 'strSlice' panic on field 'tags'")
+	is.Contains(msg, "given args (int), want func([]string) bool")
+	is.False(v.Errors.HasField("name"))
+
+	// bad filter func
+	v = Map(M{"age": 23})
+	v.AddFilter("strLen", func(s string) int {
+		return len(s)
+	})
+	v.FilterRule("age", "strLen")
+	is.NotPanics(func() {
+		is.False(v.Validate())
+	})
+	is.Contains(v.Errors.FieldOne("age"), "filter func panic")
+	is.Contains(v.Errors.FieldOne("age"), "given args (int), want func(string) int")
+	is.Contains(v.Errors.Field("age"), filterError)
+}
+
+func TestValidation_ValidateCtx(t *testing.T) {
+	is := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v := Map(M{"name": "inhere", "age": 20, "city": "chengdu"})
+	v.StringRule("name", "required|slowCheck")
+	v.StringRule("age", "required|int")
+	v.StringRule("city", "required")
+	v.AddValidator("slowCheck", func(val string) bool {
+		is.NotNil(v.Context().Done())
+		cancel() // eg: deadline exceeded on a remote check
+		return true
+	})
+
+	is.False(v.ValidateCtx(ctx))
+	is.True(v.IsIncomplete())
+	is.Contains(v.Errors.One(), "validating is aborted, context canceled")
+	is.Equal(context.Background(), v.Context())
+
+	// not canceled
+	v = Map(M{"name": "inhere"})
+	v.StringRule("name", "required")
+	is.True(v.ValidateCtx(context.Background()))
+	is.False(v.IsIncomplete())
+
+	// canceled before validating
+	v = Map(M{"name": "inhere"})
+	v.StringRule("name", "required|minLen:2")
+	is.False(v.ValidateCtx(ctx))
+	is.True(v.IsIncomplete())
+	is.Empty(v.SafeData())
+
+	v.ResetResult()
+	is.False(v.IsIncomplete())
+	is.True(v.Validate())
+}
@@ -1,9 +1,15 @@
 package validate
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/gookit/goutil/maputil"
 )
 
 // some default value settings.
@@ -17,11 +23,33 @@ const (
 
 	filterError   = "_filter"
 	validateError = "_validate"
+	timeoutError  = "_timeout"
+	fieldRefError = "_fieldRef"
 
 	// sniff Length, use for detect file mime type
 	sniffLen = 512
 	// 32 MB
 	defaultMaxMemory int64 = 32 << 20
+	// max nesting depth for parse sub-struct
+	defaultMaxDepth = 32
+)
+
+// the reasons for a field is skipped. see Validation.SkippedFields()
+const (
+	// SkipByScene field is not in the current scene
+	SkipByScene = "scene"
+	// SkipByBefore the rule before func returns false
+	SkipByBefore = "before"
+	// SkipByWhen the "when" expression of the rule is false
+	SkipByWhen = "when"
+	// SkipByDefault field use the default value, and CheckDefault is false
+	SkipByDefault = "default"
+	// SkipByOptional field value is not exists, and the rule is optional
+	SkipByOptional = "optional"
+	// SkipByEmpty field value is empty, and skip on empty
+	SkipByEmpty = "empty"
+	// SkipByFields field is excluded by OnlyFields() or ExceptFields()
+	SkipByFields = "fields"
 )
 
 // Validation definition
@@ -40,6 +68,9 @@ type Validation struct {
 	filteredData M
 	// Errors for validate
 	Errors Errors
+	// Warnings for validate. collected by AddWarning() and the "warn:" rules,
+	// they do not make the validating fail.
+	Warnings Errors
 	// CacheKey for cache rules
 	// CacheKey string
 	// StopOnError If true: An error occurs, it will cease to continue to verify
@@ -55,12 +86,24 @@ type Validation struct {
 
 	// save user custom set default values
 	defValues map[string]interface{}
+	// the length modes of the fields. see SetLengthMode()
+	lenModes map[string]string
 	// mark has error occurs
 	hasError bool
 	// mark is filtered
 	hasFiltered bool
 	// mark is validated
 	hasValidated bool
+	// mark the validating is aborted by context
+	incomplete bool
+	// validated fields. value is whether the field passed all rules.
+	validatedFields map[string]bool
+	// skipped fields and skip reason.
+	skippedFields map[string]string
+	// context for current validating, see ValidateCtx()
+	ctx context.Context
+	// guard the ctx, the abandoned validators may read it in the background. see RuleTimeout()
+	ctxMu sync.RWMutex
 	// validate rules for the validation
 	rules []*Rule
 	// validators for the validation
@@ -81,8 +124,26 @@ type Validation struct {
 	scenes SValues
 	// should check fields in current scene.
 	sceneFields map[string]uint8
+	// only check these fields. see OnlyFields()
+	onlyFields []string
+	// don't check these fields. see ExceptFields()
+	exceptFields []string
+	// input key aliases. {alias: key}. see AliasKeys()
+	aliasKeys map[string]string
+	// accepted layouts for parse time string. see TimeLayouts()
+	timeLayouts []string
+	// trim all the string values before the rules run. see TrimAllStrings()
+	trimAll bool
+	// the cutset for trim the string values, empty is trim the whitespace.
+	trimCutset string
+	// custom check the value is empty for the "required" validators. see SetRequiredChecker()
+	requiredChecker func(val interface{}) bool
+	// the execution timeouts of the validators. see RuleTimeout()
+	ruleTimeouts map[string]time.Duration
 	// filtering rules for the validation
 	filterRules []*FilterRule
+	// the derived fields, they are computed after the filter rules. see DeriveField()
+	derivedFields []*derivedField
 	// filter func reflect.Value map
 	filterValues map[string]reflect.Value
 }
@@ -104,9 +165,13 @@ func NewValidation(data DataFace, scene ...string) *Validation {
 // ResetResult reset the validate result.
 func (v *Validation) ResetResult() {
 	v.Errors = Errors{}
+	v.Warnings = Errors{}
 	v.hasError = false
 	v.hasFiltered = false
 	v.hasValidated = false
+	v.incomplete = false
+	v.validatedFields = nil
+	v.skippedFields = nil
 	// result data
 	v.safeData = make(map[string]interface{})
 	v.filteredData = make(map[string]interface{})
@@ -117,7 +182,7 @@ func (v *Validation) ResetResult() {
 // will reset
 // 	- validate result
 // 	- validate rules
-// 	- validate filterRules
+// 	- validate filterRules and derived fields
 // 	- custom validators
 func (v *Validation) Reset() {
 	v.ResetResult()
@@ -125,7 +190,105 @@ func (v *Validation) Reset() {
 	// rules
 	v.rules = v.rules[:0]
 	v.filterRules = v.filterRules[:0]
+	v.derivedFields = nil
 	v.validators = make(map[string]int8)
+	// field limits
+	v.onlyFields = nil
+	v.exceptFields = nil
+}
+
+// TimeLayouts set the accepted layouts for parse time string, will override
+// the GlobalOption.TimeLayouts. use for the date rules(eg: "date", "afterDate")
+// and binding string value to time.Time fields.
+//
+// Usage:
+// 	v.TimeLayouts("2006-01-02", time.RFC3339)
+func (v *Validation) TimeLayouts(layouts ...string) *Validation {
+	v.timeLayouts = layouts
+	if sd, ok := v.data.(*StructData); ok {
+		sd.timeLayouts = layouts
+	}
+
+	return v
+}
+
+// OnlyFields only run the rules of the given fields, independent of scenes.
+// the sub-fields of a given field are also included. eg: "user" includes "user.name"
+//
+// Usage:
+// 	v.OnlyFields("name", "email").Validate()
+func (v *Validation) OnlyFields(fields ...string) *Validation {
+	v.onlyFields = fields
+	return v
+}
+
+// ExceptFields don't run the rules of the given fields, independent of scenes.
+//
+// Usage:
+// 	v.ExceptFields("password").Validate()
+func (v *Validation) ExceptFields(fields ...string) *Validation {
+	v.exceptFields = fields
+	return v
+}
+
+// TrimAllStrings trim all the string values in the data source before the filters and rules run,
+// include the string elements of the slice and map. instead of add the "trim" filter on every field.
+// the cutset is optional, default is trim the whitespace. the raw data is not changed, see Raw()
+//
+// Usage:
+// 	v.TrimAllStrings(true)
+// 	v.TrimAllStrings(true, " \t\r\n-")
+func (v *Validation) TrimAllStrings(enable bool, cutset ...string) *Validation {
+	v.trimAll = enable
+	if len(cutset) > 0 {
+		v.trimCutset = cutset[0]
+	}
+	return v
+}
+
+// SetRequiredChecker set the custom check the value is empty(missing) for the "required" validators
+// and the skip on empty logic. the default is IsEmpty(). pass nil to reset it.
+//
+// Usage:
+// 	// treat the whitespace-only strings and the "null" literals as missing
+// 	v.SetRequiredChecker(func(val interface{}) bool {
+// 		if s, ok := val.(string); ok {
+// 			s = strings.TrimSpace(s)
+// 			return s == "" || s == "null"
+// 		}
+// 		return validate.IsEmpty(val)
+// 	})
+func (v *Validation) SetRequiredChecker(fn func(val interface{}) bool) *Validation {
+	v.requiredChecker = fn
+	return v
+}
+
+// RuleTimeout set the execution timeout of the validator, the validator is abandoned on it
+// runs out of the time, and the field got the "validation timeout" error. it is useful for the
+// slow external checks. the timeout <= 0 to remove it.
+//
+// the validator gets the context canceled on the timeout by the first arg context.Context.
+// the canceled validating context(see ValidateCtx()) marks the result incomplete, not a timeout.
+//
+// NOTICE: the abandoned validator keeps running in the background, it should stop on the context is done.
+//
+// Usage:
+// 	v.AddValidator("uniqueEmail", func(ctx context.Context, val string) bool {
+// 		return !emailExists(ctx, val)
+// 	})
+// 	v.RuleTimeout("uniqueEmail", 200*time.Millisecond)
+func (v *Validation) RuleTimeout(name string, timeout time.Duration) *Validation {
+	name = ValidatorName(name)
+	if timeout <= 0 {
+		delete(v.ruleTimeouts, name)
+		return v
+	}
+
+	if v.ruleTimeouts == nil {
+		v.ruleTimeouts = make(map[string]time.Duration)
+	}
+	v.ruleTimeouts[name] = timeout
+	return v
 }
 
 // WithSelf config the Validation instance
@@ -197,6 +360,9 @@ func (v *Validation) AddValidators(m map[string]interface{}) *Validation {
 //	})
 func (v *Validation) AddValidator(name string, checkFunc interface{}) *Validation {
 	fv := checkValidatorFunc(name, checkFunc)
+	if !fv.IsValid() { // on NoPanic=true
+		return v
+	}
 
 	v.validators[name] = 2 // custom
 	v.validatorValues[name] = fv
@@ -218,7 +384,7 @@ func (v *Validation) validatorMeta(name string) *funcMeta {
 	}
 
 	// if v.data is StructData instance.
-	if v.data.Type() == sourceStruct {
+	if v.data != nil && v.data.Type() == sourceStruct {
 		fv, ok := v.data.(*StructData).FuncValue(name)
 		if ok {
 			fm := newFuncMeta(name, false, fv)
@@ -278,18 +444,72 @@ func (v *Validation) Filtering() bool {
 		return v.IsSuccess()
 	}
 
+	v.applyAliasKeys()
+
 	// apply rule to validate data.
 	for _, rule := range v.filterRules {
 		if err := rule.Apply(v); err != nil { // has error
 			v.AddError(filterError, filterError, err.Error())
 			break
 		}
+
+		if v.shouldStop() {
+			break
+		}
+	}
+
+	if !v.shouldStop() {
+		v.applyDerivedFields()
 	}
 
 	v.hasFiltered = true
 	return v.IsSuccess()
 }
 
+// AliasKeys set the input key aliases, the alias key in input data will be
+// renamed to the key before filtering and validating.
+// only for map and form data.
+//
+// Usage:
+// 	v.AliasKeys(map[string]string{
+// 		"e-mail": "email",
+// 		"user_name": "name",
+// 	})
+func (v *Validation) AliasKeys(m map[string]string) *Validation {
+	if v.aliasKeys == nil {
+		v.aliasKeys = make(map[string]string, len(m))
+	}
+
+	for alias, key := range m {
+		v.aliasKeys[alias] = key
+	}
+	return v
+}
+
+// rename alias keys in the input data. if the key exists, alias will be ignored.
+func (v *Validation) applyAliasKeys() {
+	for alias, key := range v.aliasKeys {
+		switch d := v.data.(type) {
+		case *MapData:
+			val, ok := d.Map[alias]
+			if _, has := d.Map[key]; ok && !has {
+				d.Map[key] = val
+				delete(d.Map, alias)
+			}
+		case *FormData:
+			if vals, ok := d.Form[alias]; ok && !d.HasField(key) {
+				d.Form[key] = vals
+				d.Form.Del(alias)
+			}
+
+			if file, ok := d.Files[alias]; ok && !d.HasFile(key) {
+				d.Files[key] = file
+				d.DelFile(alias)
+			}
+		}
+	}
+}
+
 /*************************************************************
  * errors messages
  *************************************************************/
@@ -352,6 +572,21 @@ func (v *Validation) AddErrorf(field, msgFormat string, args ...interface{}) {
 	v.AddError(field, validateError, fmt.Sprintf(msgFormat, args...))
 }
 
+// AddWarning message for a field. it does not make the validating fail.
+func (v *Validation) AddWarning(field, msg string) {
+	v.addWarning(field, validateError, msg)
+}
+
+// HasWarnings check
+func (v *Validation) HasWarnings() bool {
+	return len(v.Warnings) > 0
+}
+
+func (v *Validation) addWarning(field, validator, msg string) {
+	field = v.trans.FieldName(field)
+	v.Warnings.Add(field, validator, msg)
+}
+
 // Trans get translator
 func (v *Validation) Trans() *Translator {
 	// if v.trans == nil {
@@ -412,7 +647,11 @@ func (v *Validation) tryGet(key string) (val interface{}, exist, zero bool) {
 
 	// TODO add cache data v.caches[key]
 	// get from source data
-	return v.data.TryGet(key)
+	val, exist, zero = v.data.TryGet(key)
+	if exist && v.trimAll {
+		val = trimStringValues(val, v.trimCutset)
+	}
+	return
 }
 
 // Get value by key.
@@ -444,14 +683,13 @@ func (v *Validation) Filtered(key string) interface{} {
 	return v.filteredData[key]
 }
 
-// Safe get safe value by key
+// Safe get safe value by key. support get sub value by path. eg: "profile.age"
 func (v *Validation) Safe(key string) (val interface{}, ok bool) {
 	if v.data == nil { // check input data
 		return
 	}
 
-	val, ok = v.safeData[key]
-	return
+	return maputil.GetByPath(key, v.safeData)
 }
 
 // SafeVal get safe value by key
@@ -478,7 +716,7 @@ func (v *Validation) BindSafeData(ptr interface{}) error {
 	}
 
 	// to json bytes
-	bts, err := Marshal(v.safeData)
+	bts, err := Marshal(v.parseTimeForBind(ptr))
 	if err != nil {
 		return err
 	}
@@ -497,6 +735,29 @@ func (v *Validation) Set(field string, val interface{}) error {
 	return err
 }
 
+// SetVal set value by key, support set sub value by path. eg: "profile.age"
+//
+// the value will be written back to the source data, and update the
+// filtered data(and the safe data, if the field has been validated).
+// useful for filters and struct-level validators adjust nested values.
+func (v *Validation) SetVal(field string, val interface{}) error {
+	// check input data
+	if v.data == nil {
+		return ErrEmptyData
+	}
+
+	newVal, err := v.data.Set(field, val)
+	if err != nil {
+		return err
+	}
+
+	v.filteredData[field] = newVal
+	if _, ok := v.safeData[field]; ok {
+		v.safeData[field] = newVal
+	}
+	return nil
+}
+
 // only update set value by key for struct
 func (v *Validation) updateValue(field string, val interface{}) (interface{}, error) {
 	// data source is struct
@@ -523,6 +784,25 @@ func (v *Validation) GetDefValue(field string) (interface{}, bool) {
 	return defVal, ok
 }
 
+// SetLengthMode set how to measure the string length for the length validators of the field.
+// allow: LengthModeRune, LengthModeGrapheme
+//
+// Usage:
+// 	v.SetLengthMode("nickname", validate.LengthModeGrapheme)
+// 	// same as the rule "lenMode:grapheme"
+// 	v.StringRule("nickname", "required|lenMode:grapheme|maxLen:10")
+func (v *Validation) SetLengthMode(field, mode string) {
+	if !isLengthMode(mode) {
+		configErrorf("invalid length mode '%s', allow: rune, grapheme", mode)
+		return
+	}
+
+	if v.lenModes == nil {
+		v.lenModes = make(map[string]string)
+	}
+	v.lenModes[field] = mode
+}
+
 // SceneFields field names get
 func (v *Validation) SceneFields() []string {
 	return v.scenes[v.scene]
@@ -563,6 +843,74 @@ func (v *Validation) IsSuccess() bool {
 	return !v.hasError
 }
 
+// IsIncomplete reports whether the validating was aborted by the context,
+// some fields may not be validated.
+func (v *Validation) IsIncomplete() bool {
+	return v.incomplete
+}
+
+// Context of the current validating. see ValidateCtx()
+//
+// Usage:
+// 	v.AddValidator("uniqueEmail", func(val string) bool {
+// 		return !emailExists(v.Context(), val)
+// 	})
+func (v *Validation) Context() context.Context {
+	v.ctxMu.RLock()
+	defer v.ctxMu.RUnlock()
+
+	if v.ctx == nil {
+		return context.Background()
+	}
+	return v.ctx
+}
+
+// set the context of the current validating, returns the old context.
+func (v *Validation) setContext(ctx context.Context) (old context.Context) {
+	v.ctxMu.Lock()
+	old, v.ctx = v.ctx, ctx
+	v.ctxMu.Unlock()
+	return
+}
+
+// ValidatedFields get the fields that passed through all their rules.
+// it is available after Validate().
+func (v *Validation) ValidatedFields() []string {
+	fields := make([]string, 0, len(v.validatedFields))
+	for field, ok := range v.validatedFields {
+		if ok {
+			fields = append(fields, field)
+		}
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
+// SkippedFields get the fields that not validated, value is the skip reason.
+// eg: SkipByScene, SkipByEmpty. it is available after Validate().
+func (v *Validation) SkippedFields() map[string]string {
+	mp := make(map[string]string, len(v.skippedFields))
+	for field, reason := range v.skippedFields {
+		// has been validated by other rule.
+		if _, ok := v.validatedFields[field]; !ok {
+			mp[field] = reason
+		}
+	}
+	return mp
+}
+
+// FilteredFields get the fields that has been filtered.
+func (v *Validation) FilteredFields() []string {
+	fields := make([]string, 0, len(v.filteredData))
+	for field := range v.filteredData {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
 // SafeData get all validated safe data
 func (v *Validation) SafeData() M {
 	return v.safeData
@@ -577,10 +925,148 @@ func (v *Validation) FilteredData() M {
  * helper methods
  *************************************************************/
 
+// check the value is empty by the custom required checker, default is IsEmpty().
+func (v *Validation) isEmptyValue(val interface{}) bool {
+	if v.requiredChecker != nil {
+		return v.requiredChecker(val)
+	}
+	return IsEmpty(val)
+}
+
+// check the value is not nil and not empty string. for the "requiredWith" like validators.
+func (v *Validation) notBlank(val interface{}) bool {
+	if v.requiredChecker != nil {
+		return !v.requiredChecker(val)
+	}
+	return NotEqual(val, nil) && NotEqual(val, "")
+}
+
 func (v *Validation) shouldStop() bool {
 	return v.hasError && v.StopOnError
 }
 
+// isCanceled check the context is done, will mark the result as incomplete.
+func (v *Validation) isCanceled() bool {
+	if v.incomplete {
+		return true
+	}
+
+	if v.ctx == nil {
+		return false
+	}
+
+	if err := v.ctx.Err(); err != nil {
+		v.incomplete = true
+		v.WithError(fmt.Errorf("validate: validating is aborted, %s", err.Error()))
+		return true
+	}
+	return false
+}
+
+// get the accepted layouts for parse time string, the GlobalOption.TimeLayouts
+// is used on the layouts of the validation is not set. see TimeLayouts()
+func (v *Validation) dateLayouts() []string {
+	if len(v.timeLayouts) > 0 {
+		return v.timeLayouts
+	}
+	return gOpt.TimeLayouts
+}
+
+// parse the time/duration strings in the safe data for binding to the
+// time.Time, time.Duration fields. parse time only on the time layouts has been set.
+func (v *Validation) parseTimeForBind(ptr interface{}) M {
+	layouts := v.dateLayouts()
+
+	rt := removeTypePtr(reflect.TypeOf(ptr))
+	if rt.Kind() != reflect.Struct {
+		return v.safeData
+	}
+
+	var data M
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		ft := removeTypePtr(sf.Type)
+		if ft != durationType && (ft != timeType || len(layouts) == 0) {
+			continue
+		}
+
+		// key name for Unmarshal
+		key := sf.Name
+		if name := strings.Split(sf.Tag.Get("json"), ",")[0]; name != "" {
+			key = name
+		}
+
+		str, ok := v.safeData[key].(string)
+		if !ok {
+			continue
+		}
+
+		var err error
+		var newVal interface{}
+		if ft == durationType {
+			newVal, err = time.ParseDuration(str)
+		} else {
+			newVal, err = parseTime(str, layouts)
+		}
+		if err != nil {
+			continue
+		}
+
+		// copy on write
+		if data == nil {
+			data = make(M, len(v.safeData))
+			for k, val := range v.safeData {
+				data[k] = val
+			}
+		}
+		data[key] = newVal
+	}
+
+	if data == nil {
+		return v.safeData
+	}
+	return data
+}
+
+// mark the field is validated by a rule, ok is the validate result.
+func (v *Validation) markValidated(field string, ok bool) {
+	if v.validatedFields == nil {
+		v.validatedFields = make(map[string]bool)
+	}
+
+	if passed, has := v.validatedFields[field]; has {
+		ok = ok && passed
+	}
+	v.validatedFields[field] = ok
+}
+
+// mark the field is skipped by a rule. only keep the first reason.
+func (v *Validation) markSkipped(field, reason string) {
+	if v.skippedFields == nil {
+		v.skippedFields = make(map[string]string)
+	}
+
+	if _, has := v.skippedFields[field]; !has {
+		v.skippedFields[field] = reason
+	}
+}
+
+// get the skip reason of the field, returns empty on the field need to check.
+func (v *Validation) skipReason(field string) string {
+	if v.isNotNeedToCheck(field) {
+		return SkipByScene
+	}
+
+	if len(v.onlyFields) > 0 && !matchFieldIn(field, v.onlyFields) {
+		return SkipByFields
+	}
+
+	if matchFieldIn(field, v.exceptFields) {
+		return SkipByFields
+	}
+	return ""
+}
+
 func (v *Validation) isNotNeedToCheck(field string) bool {
 	if len(v.sceneFields) == 0 {
 		return false
@@ -589,3 +1075,13 @@ func (v *Validation) isNotNeedToCheck(field string) bool {
 	_, ok := v.sceneFields[field]
 	return !ok
 }
+
+// check field is in the fields, or is sub-field of one of them.
+func matchFieldIn(field string, fields []string) bool {
+	for _, f := range fields {
+		if field == f || strings.HasPrefix(field, f+".") {
+			return true
+		}
+	}
+	return false
+}
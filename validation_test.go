@@ -3,6 +3,7 @@ package validate
 import (
 	"bytes"
 	"fmt"
+	"log"
 	"mime/multipart"
 	"net/http"
 	"net/url"
@@ -613,6 +614,49 @@ func TestFieldCompare(t *testing.T) {
 	is.Contains(emp, "newSt")
 }
 
+func TestFieldDateCompare(t *testing.T) {
+	is := assert.New(t)
+	v := Map(M{
+		"start": "2022-02-01",
+		"end":   "2022-02-15",
+		"other": "2022-02-15 10:00",
+	})
+	v.StringRules(MS{
+		"start": "required|beforeField:end|before_or_equal_field:end",
+		"end":   "required|afterField:start|afterOrEqualField:end",
+		"other": "after_field:end",
+	})
+	is.True(v.Validate())
+
+	v = Map(M{"start": "2022-02-15", "end": "2022-02-01"})
+	v.StopOnError = false
+	v.StringRule("end", "afterField:start|beforeField:notExist")
+	v.StringRule("start", "beforeOrEqualField:end")
+	is.False(v.Validate())
+	is.Equal("end value should be after the field start", v.Errors.Field("end")["afterField"])
+	is.Contains(v.Errors.Field("end"), "beforeField")
+	is.Contains(v.Errors.Field("start"), "beforeOrEqualField")
+
+	// struct with time.Time fields
+	type booking struct {
+		CheckIn  time.Time
+		CheckOut *time.Time `validate:"afterField:CheckIn"`
+	}
+	in := time.Now()
+	out := in.Add(24 * time.Hour)
+	v = Struct(&booking{CheckIn: in, CheckOut: &out})
+	is.True(v.Validate())
+
+	v = Struct(&booking{CheckIn: out, CheckOut: &in})
+	is.False(v.Validate())
+
+	// custom layouts
+	v = Map(M{"start": "01/02/2022", "end": "15/02/2022"})
+	v.TimeLayouts("02/01/2006")
+	v.StringRule("end", "afterField:start")
+	is.True(v.Validate())
+}
+
 func TestValidationScene(t *testing.T) {
 	is := assert.New(t)
 	mp := M{
@@ -1066,3 +1110,317 @@ func TestValidation_GetWithDefault(t *testing.T) {
 	assert.Equal(t, "tom", val)
 	assert.True(t, isDef)
 }
+
+func TestNoPanicMode(t *testing.T) {
+	is := assert.New(t)
+	buf := new(bytes.Buffer)
+	SetLogger(log.New(buf, "", 0))
+	Config(func(opt *GlobalOption) {
+		opt.NoPanic = true
+	})
+	defer func() {
+		SetLogger(nil)
+		ResetOption()
+	}()
+
+	// bad validator func
+	is.NotPanics(func() {
+		AddValidator("myCheck", "invalid")
+	})
+	is.NotContains(Validators(), "myCheck")
+	is.Contains(buf.String(), "validate: validator 'myCheck'. 2th parameter is invalid")
+	buf.Reset()
+
+	// bad filter func
+	is.NotPanics(func() {
+		AddFilter("bad-name", func() {})
+	})
+	is.Contains(buf.String(), "filter name bad-name is not a valid identifier")
+	buf.Reset()
+
+	// unknown validator name
+	v := Map(M{"age": 23})
+	v.StringRule("age", "not-exist")
+	is.NotPanics(func() {
+		is.False(v.Validate())
+	})
+	is.Equal("the validator 'not-exist' does not exist", v.Errors.FieldOne("age"))
+	is.Contains(buf.String(), "the validator 'not-exist' does not exist")
+
+	// arg num not match
+	v = Map(M{"age": 23})
+	v.AddRule("age", "max", 99, 34)
+	is.False(v.Validate())
+	is.Contains(v.Errors.FieldOne("age"), "the number of parameters given does not match")
+
+	// unknown calendar
+	is.Error(Val("2024-10-08", "notHoliday:nope"))
+	is.NotContains(Val("2024-10-08", "notHoliday:nope").Error(), "panic")
+	is.Error(Val("2024-10-08", "businessDay:nope"))
+	v = Map(M{"date": "2024-10-08"})
+	v.StringRule("date", "notHoliday:nope")
+	is.False(v.Validate())
+	is.NotContains(v.Errors.FieldOne("date"), "panic")
+
+	// invalid length mode, it is not stored
+	v = Map(M{"name": "👍🏽👍🏽", "nick": "👍🏽👍🏽"})
+	v.SetLengthMode("name", LengthModeGrapheme)
+	v.AddRule("name", "maxLen", 2).SetLengthMode("bytes")
+	v.SetLengthMode("nick", LengthModeGrapheme)
+	v.SetLengthMode("nick", "bytes")
+	v.StringRule("nick", "maxLen:2")
+	is.True(v.Validate())
+	is.Contains(buf.String(), "invalid length mode 'bytes', allow: rune, grapheme")
+	is.Nil(Val("👍🏽👍🏽", "lenMode:grapheme|lenMode:bytes|maxLen:2"))
+
+	// unknown enum set
+	is.Error(Val("paid", "enum:nope"))
+	is.Contains(buf.String(), "the enum 'nope' is not registered")
+
+	// unknown HTML policy, the value is escaped
+	is.Equal("&lt;b&gt;x&lt;/b&gt;", SanitizeHTML("<b>x</b>", "notExist"))
+
+	// quick validate value
+	err := Val(23, "not-exist")
+	is.Error(err)
+	is.Contains(err.Error(), "the validator 'not-exist' does not exist")
+}
+
+func TestValidation_Warnings(t *testing.T) {
+	is := assert.New(t)
+
+	v := Map(M{"name": "inhere", "password": "123456"})
+	v.StringRules(MS{
+		"name":     "required|minLen:3",
+		"password": "required|warn:minLen:10",
+	})
+	v.AddRule("name", "maxLen", 4).SetWarning(true)
+
+	is.True(v.Validate())
+	is.True(v.Errors.Empty())
+	is.True(v.HasWarnings())
+	is.True(v.Warnings.HasField("password"))
+	is.Contains(v.Warnings.Field("password"), "minLen")
+	is.True(v.Warnings.HasField("name"))
+	// the value is accepted
+	is.Equal("123456", v.SafeVal("password"))
+
+	v.AddWarning("age", "age is missing")
+	is.Equal("age is missing", v.Warnings.FieldOne("age"))
+	is.True(v.IsOK())
+
+	v.ResetResult()
+	is.False(v.HasWarnings())
+
+	// from struct tags
+	type user struct {
+		Pwd string `validate:"required|warn:minLen:10"`
+	}
+	v = Struct(&user{Pwd: "abc"})
+	is.True(v.Validate())
+	is.True(v.Warnings.HasField("Pwd"))
+
+	// quick validating
+	is.NoError(Val("abc", "required|warn:minLen:10"))
+	is.Error(Val("", "required|warn:minLen:10"))
+}
+
+func TestValidation_trackFields(t *testing.T) {
+	is := assert.New(t)
+
+	v := Map(M{
+		"name":  " inhere ",
+		"age":   "23",
+		"email": "",
+		"city":  "chengdu",
+	})
+	v.StringRule("name", "required|minLen:3", "trim")
+	v.StringRule("age", "required|int|min:30")
+	v.StringRule("email", "email")
+	v.AddRule("city", "required").SetScene("other")
+	v.StringRule("tag", "minLen:2")
+	v.AddRule("nick", "minLen", 2).SetBeforeFunc(func(v *Validation) bool {
+		return false
+	})
+	v.SetDefValue("tag", "go")
+	v.StopOnError = false
+
+	is.False(v.Validate())
+	is.Equal([]string{"name"}, v.ValidatedFields())
+	is.Equal([]string{"name"}, v.FilteredFields())
+	is.Equal(map[string]string{
+		"email": SkipByEmpty,
+		"city":  SkipByScene,
+		"nick":  SkipByBefore,
+		"tag":   SkipByDefault,
+	}, v.SkippedFields())
+
+	v.ResetResult()
+	is.Empty(v.ValidatedFields())
+	is.Empty(v.SkippedFields())
+}
+
+func TestValidation_OnlyFields(t *testing.T) {
+	is := assert.New(t)
+	data := M{
+		"name":     "inhere",
+		"email":    "bad-email",
+		"password": "",
+		"user":     M{"age": 10},
+	}
+	newV := func() *Validation {
+		v := Map(data)
+		v.StopOnError = false
+		v.StringRule("name", "required|minLen:3")
+		v.StringRule("email", "required|email")
+		v.StringRule("password", "required")
+		v.StringRule("user.age", "required|min:18")
+		return v
+	}
+
+	v := newV().OnlyFields("name", "user")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("user.age"))
+	is.False(v.Errors.HasField("email"))
+	is.False(v.Errors.HasField("password"))
+	is.Equal(SkipByFields, v.SkippedFields()["email"])
+
+	v = newV().ExceptFields("password", "email", "user.age")
+	is.True(v.Validate())
+	is.Equal([]string{"name"}, v.ValidatedFields())
+	is.Equal(SkipByFields, v.SkippedFields()["password"])
+
+	v = newV().OnlyFields("name", "password").ExceptFields("password")
+	is.True(v.Validate())
+}
+
+func TestValidation_AliasKeys(t *testing.T) {
+	is := assert.New(t)
+
+	v := Map(M{
+		"e-mail":    " some@email.com ",
+		"user_name": "inhere",
+		"name":      "tom",
+	})
+	v.AliasKeys(map[string]string{
+		"e-mail":    "email",
+		"user_name": "name",
+	})
+	v.StringRule("email", "required|email", "trim")
+	v.StringRule("name", "required")
+
+	is.True(v.Validate())
+	is.Equal("some@email.com", v.SafeVal("email"))
+	// the key is exists, alias will be ignored.
+	is.Equal("tom", v.SafeVal("name"))
+	_, ok := v.Raw("e-mail")
+	is.False(ok)
+
+	// form data
+	d := FromURLValues(url.Values{
+		"user-tags": {"go", "php"},
+	})
+	v = d.Create().AliasKeys(map[string]string{"user-tags": "tags"})
+	v.StringRule("tags", "required")
+
+	is.True(v.Validate())
+	is.Equal([]string{"go", "php"}, d.Strings("tags"))
+	is.False(d.HasField("user-tags"))
+}
+
+func TestValidation_SetVal(t *testing.T) {
+	is := assert.New(t)
+
+	d := FromMap(M{
+		"name":    "inhere",
+		"profile": map[string]interface{}{"age": 10},
+		"list":    []interface{}{map[string]interface{}{"id": 1}},
+	})
+	v := d.Create()
+	v.StringRule("name", "required")
+	v.StringRule("profile", "required")
+	v.StringRule("profile.age", "required|int")
+	is.True(v.Validate())
+
+	is.NoError(v.SetVal("profile.age", 20))
+	is.Equal(20, v.GetSafe("profile.age"))
+	is.Equal(20, d.Map["profile"].(map[string]interface{})["age"])
+	is.Equal(20, v.Filtered("profile.age"))
+
+	// create intermediate maps
+	is.NoError(v.SetVal("profile.address.city", "chengdu"))
+	is.Equal("chengdu", v.GetSafe("profile.address.city"))
+	val, ok := v.Get("profile.address.city")
+	is.True(ok)
+	is.Equal("chengdu", val)
+
+	is.NoError(v.SetVal("list.0.id", 2))
+	is.Equal(2, v.RawVal("list.0.id"))
+	is.NoError(setByPath(d.Map, "list.0.sub.id", 3))
+	is.NoError(setByPath(map[string]interface{}{"top": M{}}, "top.sub", 3))
+
+	is.Error(v.SetVal("list.3.id", 2))
+	is.Error(v.SetVal("name.first", "tom"))
+
+	is.Equal(ErrEmptyData, NewEmpty().SetVal("name", "tom"))
+}
+
+func TestValidation_TimeLayouts(t *testing.T) {
+	is := assert.New(t)
+
+	v := Map(M{
+		"start": "01/02/2022",
+		"end":   "15/02/2022",
+	})
+	v.StringRule("start", "required|date")
+	v.StringRule("end", "required|date|afterDate:01/02/2022")
+	is.False(v.Validate())
+
+	v = Map(M{
+		"start": "01/02/2022",
+		"end":   "15/02/2022",
+	})
+	v.TimeLayouts("02/01/2006", time.RFC3339)
+	v.StringRule("start", "required|date|beforeOrEqualDate:01/02/2022")
+	v.StringRule("end", "required|date|afterDate:01/02/2022")
+	is.True(v.Validate())
+
+	// the custom validator is not overridden by the layouts
+	v = Map(M{"start": "01/02/2022"})
+	v.AddValidator("isDate", func(val string) bool { return val == "tomorrow" })
+	v.TimeLayouts("02/01/2006")
+	v.StringRule("start", "date")
+	is.False(v.Validate())
+	v = Map(M{"start": "01/02/2022", "birth": "01/02/2000", "ts": "1612345678"})
+	v.TimeLayouts("02/01/2006")
+	v.StringRule("birth", "minAge:18|maxAge:99")
+	v.StringRule("ts", "tsBefore:01/02/2022")
+	v.StringRule("start", "beforeDate:02/02/2022|isBusinessDay")
+	is.True(v.Validate())
+
+	// binding
+	type form struct {
+		Start time.Time `json:"start"`
+		End   *time.Time
+	}
+	f := &form{}
+	v = Map(M{"start": "01/02/2022", "End": "15/02/2022"})
+	v.TimeLayouts("02/01/2006")
+	v.StringRule("start", "required|date")
+	v.StringRule("End", "required|date")
+	is.True(v.Validate())
+	is.NoError(v.BindSafeData(f))
+	is.Equal("2022-02-01", f.Start.Format("2006-01-02"))
+	is.Equal("2022-02-15", f.End.Format("2006-01-02"))
+	// safe data not changed
+	is.Equal("01/02/2022", v.SafeVal("start"))
+
+	// global option
+	Config(func(opt *GlobalOption) {
+		opt.TimeLayouts = []string{"2006.01.02"}
+	})
+	defer ResetOption()
+	is.True(IsDate("2022.02.01"))
+	is.False(IsDate("2022-02-01"))
+	is.True(AfterDate("2022.02.02", "2022.02.01"))
+}
@@ -28,7 +28,7 @@ func Var(val interface{}, rule string) error {
 // 	validate.Val("xyz@mail.com", "required|email")
 //
 // refer the Validation.StringRule() for parse rule string.
-func Val(val interface{}, rule string) error {
+func Val(val interface{}, rule string) (err error) {
 	rule = strings.TrimSpace(rule)
 	// input emtpy rule, skip validate
 	if rule == "" {
@@ -36,12 +36,35 @@ func Val(val interface{}, rule string) error {
 	}
 
 	field := DefaultFieldName
-	rules := stringSplit(strings.Trim(rule, "|:"), "|")
+	rules := splitRule(strings.Trim(rule, "|:"), '|')
 
 	es := make(Errors)
 	var r *Rule
 	var realName string
+	// the current validator name, the rule may be not built on panic.
+	var current string
+	lenMode := lengthModeOf(rules)
+
+	// NoPanic: report configuration problems as error.
+	if gOpt.NoPanic {
+		defer func() {
+			if rErr := recoverConfigError(recover()); rErr != nil {
+				es.Add(field, current, rErr.Error())
+				err = es
+			}
+		}()
+	}
 	for _, validator := range rules {
+		// soft constraint, there is no warnings on quick validating.
+		warn := strings.HasPrefix(validator, warnPrefix)
+		if warn {
+			validator = validator[len(warnPrefix):]
+		}
+		negate := strings.HasPrefix(validator, negatePrefix)
+		if negate {
+			validator = validator[len(negatePrefix):]
+		}
+
 		validator = strings.Trim(validator, ":")
 		if validator == "" {
 			continue
@@ -49,32 +72,55 @@ func Val(val interface{}, rule string) error {
 
 		// validator has args. eg: "min:12"
 		if strings.ContainsRune(validator, ':') {
-			list := stringSplit(validator, ":")
+			var argStr string
 			// reassign value
-			validator = list[0]
+			validator, argStr = splitRuleArgs(validator)
+			current = validator
 			realName = ValidatorName(validator)
 			switch realName {
+			// the length mode setting, see lengthModeOf()
+			case "lenMode":
+				continue
 			// eg 'regex:\d{4,6}' dont need split args. args is "\d{4,6}"
 			case "regexp":
-				// v.AddRule(field, validator, list[1])
-				r = buildRule(field, validator, realName, []interface{}{list[1]})
+				// v.AddRule(field, validator, argStr)
+				r = buildRule(field, validator, realName, []interface{}{unquoteArg(argStr)})
+			// the value must pass any of the rule groups. eg: "or:(email)(phone:US)"
+			case orRuleName:
+				groups := parseRuleGroups(field, argStr)
+				if groups == nil {
+					continue
+				}
+				r = buildRule(field, validator, realName, strings2Args(splitRuleGroups(argStr)))
+				r.groups = groups
 				// some special validator. need merge args to one.
 			case "enum", "notIn":
-				arg := parseArgString(list[1])
+				arg := parseArgString(argStr)
 				// ev.AddRule(field, validator, arg)
 				r = buildRule(field, validator, realName, []interface{}{arg})
+			// the case-insensitive flag. eg: "startsWith:img_,pic_:i"
+			case "startsWith", "endsWith", "notStartsWith", "notEndsWith":
+				realName, argStr = foldAffixRule(realName, argStr)
+				r = buildRule(field, validator, realName, parseRuleArgs(argStr))
 			default:
-				args := parseArgString(list[1])
-				r = buildRule(field, validator, realName, strings2Args(args))
+				r = buildRule(field, validator, realName, parseRuleArgs(argStr))
 			}
 		} else {
+			current = validator
 			realName = ValidatorName(validator)
 			r = buildRule(field, validator, realName, nil)
 		}
 
+		r.lenMode = lenMode
+		if negate {
+			r.SetNegate(true)
+		}
 		// validate value use validator.
-		if !r.valueValidate(field, realName, val, emptyV) {
-			es.Add(field, validator, r.errorMessage(field, r.validator, emptyV))
+		if ok, vErr := r.safeValueValidate(field, realName, val, emptyV); vErr != nil {
+			es.Add(field, r.validator, vErr.Error())
+			break
+		} else if !ok && !warn {
+			es.Add(field, r.validator, r.errorMessage(field, r.validator, val, emptyV))
 			break
 		}
 	}
@@ -100,6 +146,9 @@ func buildRule(fields, validator, realName string, args []interface{}) *Rule {
 func newValValidation() *Validation {
 	v := &Validation{
 		trans: NewTranslator(),
+		// the errors may be added on validating. eg: convert the arg type failed
+		Errors:   make(Errors),
+		Warnings: make(Errors),
 		// validator names
 		validators: make(map[string]int8, 2),
 	}
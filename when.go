@@ -0,0 +1,455 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/gookit/goutil/strutil"
+)
+
+// the expression of the "when" rule, it gates the rules of the field.
+//
+// Syntax:
+//   - field path: type, user.age, tags.0
+//   - literals: 'corp', "corp", 12, 1.5, true, false, nil, ['US', 'CA']
+//   - compare: == != > >= < <= in, not in
+//   - logic: && || ! and the parentheses
+//
+// eg: `type=='corp' && country in ['US','CA']`
+type whenExpr struct {
+	text string
+	root exprNode
+}
+
+// evaluate the expression by the current(filtered) field values.
+func (e *whenExpr) match(v *Validation) bool {
+	return exprTruthy(e.root.eval(v))
+}
+
+var (
+	whenExprMu sync.RWMutex
+	// the compiled expressions. key is the expression text
+	whenExprCache = make(map[string]*whenExpr)
+)
+
+// compile the "when" expression, the compiled expression is cached.
+// returns nil on the expression is invalid.
+func compileWhen(text string) *whenExpr {
+	text = strings.TrimSpace(text)
+
+	whenExprMu.RLock()
+	e, ok := whenExprCache[text]
+	whenExprMu.RUnlock()
+	if ok {
+		return e
+	}
+
+	p := &exprParser{src: text}
+	root, err := p.parse()
+	if err != nil {
+		configErrorf("invalid when expression '%s': %s", text, err.Error())
+		return nil
+	}
+
+	e = &whenExpr{text: text, root: root}
+	whenExprMu.Lock()
+	whenExprCache[text] = e
+	whenExprMu.Unlock()
+	return e
+}
+
+/*************************************************************
+ * expression nodes
+ *************************************************************/
+
+type exprNode interface {
+	eval(v *Validation) interface{}
+}
+
+type (
+	// literal value. eg: 'corp', 12, true
+	litNode struct{ val interface{} }
+	// field value. eg: user.age
+	fieldNode struct{ path string }
+	// list literal. eg: ['US', 'CA']
+	listNode struct{ items []exprNode }
+	// logic not. eg: !isAdmin
+	notNode struct{ x exprNode }
+	// logic and, or. eg: a && b
+	logicNode struct {
+		op   string
+		l, r exprNode
+	}
+	// compare. eg: age >= 18
+	cmpNode struct {
+		op   string
+		l, r exprNode
+	}
+)
+
+func (n *litNode) eval(_ *Validation) interface{} { return n.val }
+
+func (n *fieldNode) eval(v *Validation) interface{} {
+	val, _ := v.Get(n.path)
+	return val
+}
+
+func (n *listNode) eval(v *Validation) interface{} {
+	list := make([]interface{}, len(n.items))
+	for i, item := range n.items {
+		list[i] = item.eval(v)
+	}
+	return list
+}
+
+func (n *notNode) eval(v *Validation) interface{} {
+	return !exprTruthy(n.x.eval(v))
+}
+
+func (n *logicNode) eval(v *Validation) interface{} {
+	l := exprTruthy(n.l.eval(v))
+	if n.op == "&&" {
+		return l && exprTruthy(n.r.eval(v))
+	}
+	return l || exprTruthy(n.r.eval(v))
+}
+
+func (n *cmpNode) eval(v *Validation) interface{} {
+	l, r := n.l.eval(v), n.r.eval(v)
+	switch n.op {
+	case "==":
+		return exprEqual(l, r)
+	case "!=":
+		return !exprEqual(l, r)
+	case "in":
+		return exprIn(l, r)
+	case "not in":
+		return !exprIn(l, r)
+	}
+
+	if l == nil || r == nil {
+		return false
+	}
+
+	// compare as number, fallback to compare as string
+	var c int
+	lf, err1 := mathutil.ToFloat(l)
+	rf, err2 := mathutil.ToFloat(r)
+	if err1 == nil && err2 == nil {
+		switch {
+		case lf < rf:
+			c = -1
+		case lf > rf:
+			c = 1
+		}
+	} else {
+		c = strings.Compare(strutil.MustString(l), strutil.MustString(r))
+	}
+
+	switch n.op {
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	}
+	return c <= 0 // "<="
+}
+
+// the value is true, or is not empty
+func exprTruthy(val interface{}) bool {
+	if b, ok := val.(bool); ok {
+		return b
+	}
+	return val != nil && !IsEmpty(val)
+}
+
+// check the values are equal. the number and numeric string are compared as number. eg: 12 == '12'
+func exprEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	af, err1 := mathutil.ToFloat(a)
+	bf, err2 := mathutil.ToFloat(b)
+	if err1 == nil && err2 == nil {
+		return af == bf
+	}
+	return strutil.MustString(a) == strutil.MustString(b)
+}
+
+// check the value is in the list, or is substring of the string.
+func exprIn(val, list interface{}) bool {
+	if list == nil {
+		return false
+	}
+	if str, ok := list.(string); ok {
+		return val != nil && strings.Contains(str, strutil.MustString(val))
+	}
+
+	rv := reflect.ValueOf(list)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if exprEqual(val, rv.Index(i).Interface()) {
+				return true
+			}
+		}
+	case reflect.Map:
+		for _, key := range rv.MapKeys() {
+			if exprEqual(val, key.Interface()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/*************************************************************
+ * expression parser
+ *************************************************************/
+
+// the recursive descent parser of the "when" expression.
+//
+//	or      = and { "||" and }
+//	and     = not { "&&" not }
+//	not     = "!" not | compare
+//	compare = operand [ ("==" | "!=" | ">" | ">=" | "<" | "<=" | "in" | "not in") operand ]
+//	operand = literal | field | list | "(" or ")"
+type exprParser struct {
+	src string
+	pos int
+}
+
+func (p *exprParser) parse() (exprNode, error) {
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.skipSpace(); p.pos < len(p.src) {
+		return nil, p.errorf("unexpected '%s'", p.src[p.pos:])
+	}
+	return node, nil
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	l, err := p.parseAnd()
+	for err == nil && p.consume("||") {
+		var r exprNode
+		if r, err = p.parseAnd(); err == nil {
+			l = &logicNode{op: "||", l: l, r: r}
+		}
+	}
+	return l, err
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	l, err := p.parseNot()
+	for err == nil && p.consume("&&") {
+		var r exprNode
+		if r, err = p.parseNot(); err == nil {
+			l = &logicNode{op: "&&", l: l, r: r}
+		}
+	}
+	return l, err
+}
+
+func (p *exprParser) parseNot() (exprNode, error) {
+	p.skipSpace()
+	if strings.HasPrefix(p.src[p.pos:], "!") && !strings.HasPrefix(p.src[p.pos:], "!=") {
+		p.pos++
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{x: x}, nil
+	}
+	return p.parseCompare()
+}
+
+// the compare operators, the longer first.
+var exprCmpOps = []string{"==", "!=", ">=", "<=", ">", "<"}
+
+func (p *exprParser) parseCompare() (exprNode, error) {
+	l, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	var op string
+	for _, cmpOp := range exprCmpOps {
+		if p.consume(cmpOp) {
+			op = cmpOp
+			break
+		}
+	}
+	if op == "" {
+		if p.consumeWord("in") {
+			op = "in"
+		} else if start := p.pos; p.consumeWord("not") {
+			if !p.consumeWord("in") {
+				p.pos = start
+				return nil, p.errorf("expect 'in' after 'not'")
+			}
+			op = "not in"
+		} else {
+			return l, nil
+		}
+	}
+
+	r, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return &cmpNode{op: op, l: l, r: r}, nil
+}
+
+func (p *exprParser) parseOperand() (exprNode, error) {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return nil, p.errorf("unexpected end")
+	}
+
+	switch c := p.src[p.pos]; {
+	case c == '(':
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("expect ')'")
+		}
+		return node, nil
+	case c == '[':
+		return p.parseList()
+	case c == '\'' || c == '"':
+		str, err := p.parseString(c)
+		if err != nil {
+			return nil, err
+		}
+		return &litNode{val: str}, nil
+	case c == '-' || c >= '0' && c <= '9':
+		return p.parseNumber()
+	case isExprIdentChar(c):
+		start := p.pos
+		for p.pos < len(p.src) && isExprIdentChar(p.src[p.pos]) {
+			p.pos++
+		}
+
+		switch word := p.src[start:p.pos]; word {
+		case "true", "false":
+			return &litNode{val: word == "true"}, nil
+		case "nil", "null":
+			return &litNode{}, nil
+		default:
+			return &fieldNode{path: word}, nil
+		}
+	}
+	return nil, p.errorf("unexpected '%c'", p.src[p.pos])
+}
+
+func (p *exprParser) parseList() (exprNode, error) {
+	p.pos++ // skip "["
+	list := &listNode{}
+	if p.consume("]") {
+		return list, nil
+	}
+
+	for {
+		item, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		list.items = append(list.items, item)
+
+		if p.consume("]") {
+			return list, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expect ',' or ']'")
+		}
+	}
+}
+
+func (p *exprParser) parseString(quote byte) (string, error) {
+	var sb strings.Builder
+	for p.pos++; p.pos < len(p.src); p.pos++ {
+		c := p.src[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			sb.WriteByte(p.src[p.pos])
+		case c == quote:
+			p.pos++
+			return sb.String(), nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *exprParser) parseNumber() (exprNode, error) {
+	start := p.pos
+	p.pos++ // the sign or the first digit
+	for p.pos < len(p.src) && (p.src[p.pos] == '.' || p.src[p.pos] >= '0' && p.src[p.pos] <= '9') {
+		p.pos++
+	}
+
+	text := p.src[start:p.pos]
+	if i64, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return &litNode{val: i64}, nil
+	}
+
+	f64, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("invalid number '%s'", text)
+	}
+	return &litNode{val: f64}, nil
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// consume the token on it is the next.
+func (p *exprParser) consume(token string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.src[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// consume the keyword on it is the next whole word.
+func (p *exprParser) consumeWord(word string) bool {
+	p.skipSpace()
+	end := p.pos + len(word)
+	if !strings.HasPrefix(p.src[p.pos:], word) || end < len(p.src) && isExprIdentChar(p.src[end]) {
+		return false
+	}
+
+	p.pos = end
+	return true
+}
+
+func (p *exprParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// the field path chars. eg: user.tags.0
+func isExprIdentChar(c byte) bool {
+	return c == '_' || c == '.' || c == '*' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}